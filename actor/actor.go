@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"github.com/op/go-logging"
 	"gitlab.com/Habimm/tree-search-golang/config"
+	"gitlab.com/Habimm/tree-search-golang/gogame"
 	"gitlab.com/Habimm/tree-search-golang/treesearch"
 	"gitlab.com/Habimm/tree-search-golang/predictor"
 	"gitlab.com/Habimm/tree-search-golang/record"
@@ -17,7 +18,7 @@ var (
     log = logging.MustGetLogger("actor")
 )
 
-func handleCommands() {
+func handleCommands(searchMode treesearch.SearchMode) {
 	for {
 		commandsFile, err := os.Open(config.String["commands_path"])
 		if err != nil {
@@ -43,6 +44,10 @@ func handleCommands() {
 			case "LoadModel":
 				modelPath := commandMap["model_path"].(string)
 				log.Debugf("Received command to load new model %s", modelPath)
+				if searchMode == treesearch.UCT_SEARCH {
+					log.Warningf("Ignoring the model %s because UCT does not use any", modelPath)
+					continue
+				}
 				predictor.StopService()
 				predictor.StartService(modelPath)
 			default:
@@ -57,6 +62,9 @@ type Example struct {
 	Observation [][][]float32
 	Policy		[]float32
 	Outcome 	float32
+	Ownership	[]float32 // for every position, 1 if the player to move owned it at the end, -1 if the opponent did
+
+	color		int // the player to move
 }
 
 func SendExperience(experienceChan chan Example) {
@@ -76,7 +84,7 @@ func SendExperience(experienceChan chan Example) {
 	experienceBytes := make([]byte, 0)
 	isOpen := true
 	for isOpen {
-		// num_examples_per_file > 2*max_game_length
+		// num_examples_per_file > 2*max_moves
 		oldModelPath := config.String["model_path"]
 		experienceBytes = experienceBytes[:0]
 		// collect examples from SelfPlay through the experience channel
@@ -118,41 +126,45 @@ func SendExperience(experienceChan chan Example) {
 	log.Debugf("Closed experience pipe")
 }
 
+// expectedGameLength is the number of moves after which the options end a game, or a guess if they set no limit
+func expectedGameLength(options gogame.Options) int {
+	if options.MaxMoves > 0 {
+		return options.MaxMoves
+	}
+	return 2 * options.NumPoints()
+}
+
 func SelfPlay(
 	searcher *treesearch.Agent,
 	experienceChan chan Example,
 	recordsChan chan *record.Info) {
-	maxGameLength := config.Int["max_game_length"]
-	explorationLength := config.Int["exploration_length"]
-	examples := make([]Example, 0, maxGameLength)
+	temperature := treesearch.DefaultTemperatureSchedule()
+	examples := make([]Example, 0, expectedGameLength(searcher.Options()))
 	gameLength := 0
 	start := time.Now()
-	searcher.Reset()
+	setup := gogame.DefaultSetup(searcher.Options())
+	searcher.ResetFromSetup(setup)
 	record := &record.Info{
 		InitialColor: searcher.Color(),
+		Options: searcher.Options(),
+		Setup: setup,
 		Actions: make([]int, 0),
 		BlackName: searcher.Name(),
 		WhiteName: searcher.Name()}
-	for !searcher.Finished() && gameLength < maxGameLength {
+	// the options of the searcher end the game after max_moves moves
+	for !searcher.Finished() {
 		searcher.Search()
-		var (
-			actionIdx int
-			policy []float32
-		)
-		if gameLength < explorationLength {
-			actionIdx, policy = searcher.Explore()
-		} else {
-			actionIdx, policy = searcher.Exploit()
-		}
+		actionIdx, policy := searcher.SelectMove(temperature(gameLength))
 		gameLength++
 		action := searcher.FavourableLegalActions()[actionIdx]
 		record.Actions = append(record.Actions, action)
 		log.Infof("Taking action %d", action)
-		example := Example{Observation: searcher.Observation(), Policy: policy}
+		example := Example{Observation: searcher.Observation(), Policy: policy, color: searcher.Color()}
 		examples = append(examples, example)
 		searcher.Step(actionIdx)
 	}
 	outcome := searcher.Outcome()
+	ownership := searcher.Ownership() // from Black's view
 
 	// queue game record for writing
 	record.Outcome = outcome
@@ -160,8 +172,19 @@ func SelfPlay(
 
 	// queue experience for writing
 	for t := len(examples)-1; t >= 0; t-- {
-		outcome *= -1.0
+		// a draw stays 0 rather than becoming -0 for either player
+		if outcome != 0.0 {
+			outcome *= -1.0
+		}
 		examples[t].Outcome = outcome
+		examples[t].Ownership = make([]float32, len(ownership))
+		for pos, value := range ownership {
+			// an unowned position stays 0 rather than becoming -0
+			if examples[t].color == config.WHITE && value != 0.0 {
+				value = -value
+			}
+			examples[t].Ownership[pos] = value
+		}
 		experienceChan<- examples[t]
 	}
 
@@ -183,17 +206,29 @@ func main() {
 	logging.SetLevel(logging.ERROR, "record")
 	logging.SetLevel(logging.ERROR, "gogame")
 
-	predictor.StartService(config.String["model_path"])
+	// UCT with random rollouts plays the games for the very first model generation, which has no model to load yet
+	searchMode := treesearch.SearchModeFromString(config.String["search_mode"])
+	if searchMode == treesearch.PUCT_SEARCH {
+		predictor.StartService(config.String["model_path"])
+	}
 
-	experienceChan := make(chan Example, config.Int["max_game_length"])
+	options := gogame.DefaultOptions()
+	experienceChan := make(chan Example, expectedGameLength(options))
 	go SendExperience(experienceChan)
 
 	recordsChan := make(chan *record.Info, 1)
 	go record.Save(recordsChan)
 
-	go handleCommands()
+	go handleCommands(searchMode)
 
-	searcher := treesearch.New(predictor.RequestsChannel)
+	var searcher *treesearch.Agent
+	if searchMode == treesearch.UCT_SEARCH {
+		searcher = treesearch.NewUCT(options)
+	} else {
+		searcher = treesearch.New(predictor.NewEvaluator(predictor.RequestsChannel), options)
+	}
+	searcher.SetRootNoise(config.Float["root_noise_alpha"], config.Float["root_noise_fraction"])
+	searcher.SetForcedPlayouts(config.Float["forced_playouts"])
 	for i := 0; ; i++ {
 		SelfPlay(searcher, experienceChan, recordsChan)
 		log.Infof("Played game %d", i)
@@ -2,23 +2,44 @@ package config
 
 var (
 	Float = map[string]float32{
-		"komi": 5.5}
+		"komi": 5.5,
+		"root_noise_alpha": 0.3,
+		"root_noise_fraction": 0.25,
+		"temperature": 1.0,
+		"final_temperature": 0.0,
+		"forced_playouts": 0.0,
+		"uct_exploration": 1.414}
 
 	Int = map[string]int{
 		"boardsize": 5,
-		"max_game_length": 20,
 		"exploration_length": 3,
 		"predict_batch_size": 2,
 		"num_examples_per_file": 41,
 		"nsims_per_goroutine": 600,
 		"random_seed": 3,
 		"num_eval_games": 1,
-		"history_size": 4}
+		"history_size": 4,
+		"dead_stone_playouts": 64,
+		"max_moves": 20,
+		"no_pass_moves": 0,
+		"temperature_half_life": 10,
+		"uct_rollouts": 1,
+		"handicap": 0}
 
 	String = map[string]string{
 		"exp_prefix": "exp",
 		"record_prefix": "sgf",
 		"commands_path": "commands",
+		"ko_rule": "positional",
+		"rules": "tromp-taylor",
+		"feature_set": "basic",
+		"dead_stones": "all-alive",
+		"action_filters": "own_eye",
+		"max_moves_scoring": "score",
+		"cleanup": "none",
+		"temperature_schedule": "step",
+		"search_mode": "puct",
+		"eval_opponent": "random",
 		"model_path": "/home/tischler/Software/tischler/main/out/mod/uibam-tf"}
 )
 
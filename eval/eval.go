@@ -6,6 +6,7 @@ import (
     "math/rand"
     "github.com/op/go-logging"
     "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
     "gitlab.com/Habimm/tree-search-golang/record"
     "gitlab.com/Habimm/tree-search-golang/predictor"
     "gitlab.com/Habimm/tree-search-golang/treesearch"
@@ -16,15 +17,22 @@ var (
     log = logging.MustGetLogger("eval")
 )
 
-func Play(searcher *treesearch.Agent, searcherColor int, recordsChan chan *record.Info) {
+// Play lets the searcher play a game against the opponent, which is the random player if it is nil
+func Play(searcher *treesearch.Agent, opponent *treesearch.Agent, searcherColor int, recordsChan chan *record.Info) {
     start := time.Now()
-    searcher.Reset()
-    record := &record.Info{InitialColor: searcher.Color(), Actions: make([]int, 0)}
+    setup := gogame.DefaultSetup(searcher.Options())
+    searcher.ResetFromSetup(setup)
+    opponentName := "Random player"
+    if opponent != nil {
+        opponent.ResetFromSetup(setup)
+        opponentName = opponent.Name()
+    }
+    record := &record.Info{InitialColor: searcher.Color(), Options: searcher.Options(), Setup: setup, Actions: make([]int, 0)}
     if searcherColor == config.BLACK {
         record.BlackName = searcher.Name()
-        record.WhiteName = "Random player"
+        record.WhiteName = opponentName
     } else {
-        record.BlackName = "Random player"
+        record.BlackName = opponentName
         record.WhiteName = searcher.Name()
     }
     gameLength := 0
@@ -33,20 +41,27 @@ func Play(searcher *treesearch.Agent, searcherColor int, recordsChan chan *recor
         if searcher.Color() == searcherColor {
             searcher.Search()
             actionIdx, _ = searcher.Exploit()
+        } else if opponent != nil {
+            opponent.Search()
+            actionIdx, _ = opponent.Exploit()
         } else {
             actionIdx = randomplay.QuickStep(searcher.FavourableLegalActions())
         }
         action := searcher.FavourableLegalActions()[actionIdx]
         record.Actions = append(record.Actions, action)
         searcher.Step(actionIdx)
+        if opponent != nil {
+            // both agents play with the same options, so they agree on the favourable legal actions
+            opponent.Step(actionIdx)
+        }
     }
     outcome := searcher.Outcome()
     record.Outcome = outcome
     recordsChan<- record
 
     elapsed := time.Now().Sub(start)
-    log.Infof("Performed a play with outcome %.0f of length %d with the tree search agent in color %d against the random player in %v",
-        outcome, gameLength, searcherColor, elapsed)
+    log.Infof("Performed a play with outcome %.0f of length %d with the tree search agent in color %d against the %s in %v",
+        outcome, gameLength, searcherColor, opponentName, elapsed)
 }
 
 func main() {
@@ -69,13 +84,22 @@ func main() {
     recordsChan := make(chan *record.Info, 1)
     go record.SaveRecords(recordsChan)
 
-    searcher := treesearch.New(predictor.RequestsChannel)
+    options := gogame.DefaultOptions()
+    searcher := treesearch.New(predictor.NewEvaluator(predictor.RequestsChannel), options)
+    var opponent *treesearch.Agent
+    switch name := config.String["eval_opponent"]; name {
+    case "random":
+    case "uct":
+        opponent = treesearch.NewUCT(options)
+    default:
+        log.Panicf("Unaccepted evaluation opponent %s (only random and uct)", name)
+    }
     numEvalGames := config.Int["num_eval_games"]
     log.Debugf("%d", numEvalGames)
     for g := 0; ; g++ {
-        Play(searcher, config.BLACK, recordsChan)
+        Play(searcher, opponent, config.BLACK, recordsChan)
         log.Infof("Played game with searcher as Black %d", g)
-        Play(searcher, config.WHITE, recordsChan)
+        Play(searcher, opponent, config.WHITE, recordsChan)
         log.Infof("Played game with searcher as White %d", g)
     }
 
@@ -0,0 +1,193 @@
+package gogame
+
+import (
+	"sync"
+)
+
+var (
+	// adjacency tables only depend on the board size, so all games of one size share a table
+	adjacencyTables = make(map[[2]int][][]int) // indexed by width and height
+	adjacencyMutex sync.Mutex
+)
+
+// adjacencyTable returns, for each position, the positions to its left, right, above and below, if on the board
+func adjacencyTable(width int, height int) [][]int {
+	adjacencyMutex.Lock()
+	defer adjacencyMutex.Unlock()
+	table, present := adjacencyTables[[2]int{width, height}]
+	if present {
+		return table
+	}
+
+	boardLength := width * height
+	table = make([][]int, boardLength)
+	for pos := range table {
+		if pos % width != 0 {
+			table[pos] = append(table[pos], pos-1)
+		}
+		if (pos+1) % width != 0 {
+			table[pos] = append(table[pos], pos+1)
+		}
+		if pos >= width {
+			table[pos] = append(table[pos], pos-width)
+		}
+		if pos < boardLength-width {
+			table[pos] = append(table[pos], pos+width)
+		}
+	}
+	adjacencyTables[[2]int{width, height}] = table
+	return table
+}
+
+// newMark returns a fresh generation for the marks array, so that no position counts as marked
+func (game *Game) newMark() int {
+	if len(game.marks) != len(game.board) {
+		game.marks = make([]int, len(game.board))
+		game.markGen = 0
+	}
+	game.markGen++
+	return game.markGen
+}
+
+// placeStone puts a stone on an empty position, merges it with its friendly neighbour chains
+// and removes the chains left without liberties, first the enemy ones and then its own
+// the captured positions are appended to the given slices
+func (game *Game) placeStone(pos int, color int, enemyCaptured []int, ownCaptured []int) ([]int, []int) {
+	game.board[pos] = color
+	game.chainHead[pos] = pos
+	game.chainNext[pos] = pos
+	game.chainSize[pos] = 1
+	game.chainLiberties[pos] = 0
+
+	// pos is no longer a liberty of any neighbour chain
+	mark := game.newMark()
+	for _, neigh := range game.adjacent[pos] {
+		if game.board[neigh] == EMPTY {
+			game.chainLiberties[pos]++
+			continue
+		}
+		head := game.chainHead[neigh]
+		if game.marks[head] != mark {
+			game.marks[head] = mark
+			game.chainLiberties[head]--
+		}
+	}
+
+	// join the new stone with its friendly neighbour chains
+	merged := false
+	for _, neigh := range game.adjacent[pos] {
+		if game.board[neigh] == color && game.chainHead[neigh] != game.chainHead[pos] {
+			game.mergeChains(game.chainHead[pos], game.chainHead[neigh])
+			merged = true
+		}
+	}
+	if merged {
+		head := game.chainHead[pos]
+		game.chainLiberties[head] = game.countLiberties(head)
+	}
+
+	otherColor := other(color)
+	for _, neigh := range game.adjacent[pos] {
+		if game.board[neigh] == otherColor && game.chainLiberties[game.chainHead[neigh]] == 0 {
+			enemyCaptured = game.removeChain(game.chainHead[neigh], enemyCaptured)
+		}
+	}
+	if game.chainLiberties[game.chainHead[pos]] == 0 {
+		ownCaptured = game.removeChain(game.chainHead[pos], ownCaptured)
+	}
+	return enemyCaptured, ownCaptured
+}
+
+// mergeChains relabels the smaller of two chains with the representative of the larger one
+func (game *Game) mergeChains(head int, otherHead int) {
+	if game.chainSize[head] < game.chainSize[otherHead] {
+		head, otherHead = otherHead, head
+	}
+	pos := otherHead
+	for {
+		game.chainHead[pos] = head
+		pos = game.chainNext[pos]
+		if pos == otherHead {
+			break
+		}
+	}
+	game.chainNext[head], game.chainNext[otherHead] = game.chainNext[otherHead], game.chainNext[head]
+	game.chainSize[head] += game.chainSize[otherHead]
+}
+
+// removeChain takes a chain off the board, gives its positions as liberties to the neighbouring chains
+// and appends the removed positions to captured
+func (game *Game) removeChain(head int, captured []int) []int {
+	start := len(captured)
+	pos := head
+	for {
+		captured = append(captured, pos)
+		game.board[pos] = EMPTY
+		pos = game.chainNext[pos]
+		if pos == head {
+			break
+		}
+	}
+	for _, pos := range captured[start:] {
+		game.chainHead[pos] = UNDEF
+	}
+
+	for _, pos := range captured[start:] {
+		mark := game.newMark()
+		for _, neigh := range game.adjacent[pos] {
+			if game.board[neigh] == EMPTY {
+				continue
+			}
+			neighHead := game.chainHead[neigh]
+			if game.marks[neighHead] != mark {
+				game.marks[neighHead] = mark
+				game.chainLiberties[neighHead]++
+			}
+		}
+	}
+	return captured
+}
+
+// countLiberties counts the distinct empty positions next to a chain
+func (game *Game) countLiberties(head int) (liberties int) {
+	mark := game.newMark()
+	pos := head
+	for {
+		for _, neigh := range game.adjacent[pos] {
+			if game.board[neigh] == EMPTY && game.marks[neigh] != mark {
+				game.marks[neigh] = mark
+				liberties++
+			}
+		}
+		pos = game.chainNext[pos]
+		if pos == head {
+			break
+		}
+	}
+	return
+}
+
+// rebuildChains recomputes all chain bookkeeping from the colors on the board
+func (game *Game) rebuildChains() {
+	for pos := range game.board {
+		game.chainHead[pos] = UNDEF
+	}
+	for pos, color := range game.board {
+		if color == EMPTY {
+			continue
+		}
+		game.chainHead[pos] = pos
+		game.chainNext[pos] = pos
+		game.chainSize[pos] = 1
+		for _, neigh := range game.adjacent[pos] {
+			if neigh < pos && game.board[neigh] == color && game.chainHead[neigh] != game.chainHead[pos] {
+				game.mergeChains(game.chainHead[pos], game.chainHead[neigh])
+			}
+		}
+	}
+	for pos := range game.board {
+		if game.chainHead[pos] == pos {
+			game.chainLiberties[pos] = game.countLiberties(pos)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package gogame
+
+import (
+	"testing"
+	"math/rand"
+	"gitlab.com/Habimm/tree-search-golang/config"
+)
+
+// checkChains compares the incrementally kept chain bookkeeping with the one rebuilt from scratch
+func checkChains(t *testing.T, game *Game) {
+	rebuilt := game.Copy()
+	rebuilt.rebuildChains()
+	for pos, color := range game.board {
+		if color == EMPTY {
+			if game.chainHead[pos] != UNDEF {
+				t.Errorf("Empty position %d belongs to chain %d", pos, game.chainHead[pos])
+			}
+			continue
+		}
+		head, rebuiltHead := game.chainHead[pos], rebuilt.chainHead[pos]
+		if game.chainLiberties[head] != rebuilt.chainLiberties[rebuiltHead] {
+			t.Errorf("Stone %d has %d liberties but should have %d",
+				pos, game.chainLiberties[head], rebuilt.chainLiberties[rebuiltHead])
+		}
+		if game.chainSize[head] != rebuilt.chainSize[rebuiltHead] {
+			t.Errorf("Stone %d is in a chain of size %d but should be in one of size %d",
+				pos, game.chainSize[head], rebuilt.chainSize[rebuiltHead])
+		}
+		for _, neigh := range game.adjacent[pos] {
+			if game.board[neigh] == color && game.chainHead[neigh] != head {
+				t.Errorf("Neighbouring stones %d and %d are in different chains", pos, neigh)
+			}
+		}
+	}
+}
+
+func TestChainBookkeeping(t *testing.T) {
+	random := rand.New(rand.NewSource(int64(config.Int["random_seed"])))
+	for _, size := range [][2]int{{5, 5}, {9, 9}, {13, 13}, {19, 19}, {7, 11}, {11, 3}} {
+		options := DefaultOptions()
+		options.Width, options.Height = size[0], size[1]
+		for g := 0; g < 20; g++ {
+			game := New(options)
+			for moves := 0; !game.Finished() && moves < 4*game.PassAction(); moves++ {
+				legalActions := game.FavourableLegalActions()
+				game.Step(legalActions[random.Intn(len(legalActions))])
+				checkChains(t, game)
+			}
+		}
+	}
+}
+
+func BenchmarkRandomGame(b *testing.B) {
+	options := DefaultOptions()
+	options.Width, options.Height = 9, 9
+	random := rand.New(rand.NewSource(int64(config.Int["random_seed"])))
+	for i := 0; i < b.N; i++ {
+		game := New(options)
+		for moves := 0; !game.Finished() && moves < 4*game.PassAction(); moves++ {
+			legalActions := game.FavourableLegalActions()
+			game = game.Copy()
+			game.Step(legalActions[random.Intn(len(legalActions))])
+		}
+	}
+}
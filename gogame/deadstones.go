@@ -0,0 +1,355 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"math/rand"
+)
+
+/**
+	dead stone estimation decides which stones are removed before a finished game is scored
+	- stones that are unconditionally alive by Benson's algorithm are never dead,
+	- stones inside the eyes of unconditionally alive chains are always dead,
+	- chains in seki are alive, and the empty points they share with the opponent belong to no one,
+	- every other chain is dead if random playouts from the final position lose it more often than they keep it
+*/
+
+// deadStones flags every stone that is removed before scoring, and every stone that is in seki
+func (game *Game) deadStones() (dead []bool, seki []bool) {
+	dead = make([]bool, len(game.board))
+	seki = make([]bool, len(game.board))
+
+	alive := make([]bool, len(game.board))
+	for _, color := range [2]int{config.BLACK, config.WHITE} {
+		game.unconditionalLife(color, alive, dead)
+	}
+	for pos := range game.board {
+		if game.chainHead[pos] == pos && !alive[pos] && game.inSeki(pos) {
+			game.flagChain(pos, seki)
+		}
+	}
+
+	var ownership []float32
+	for pos, color := range game.board {
+		if color == EMPTY || game.chainHead[pos] != pos || alive[pos] || seki[pos] || dead[pos] {
+			continue
+		}
+		if ownership == nil {
+			ownership = game.playoutOwnership(game.options.DeadStonePlayouts)
+		}
+
+		// the chain is dead if, averaged over its stones, the opponent owns it
+		var owned float32
+		stone := pos
+		for {
+			owned += ownership[stone]
+			stone = game.chainNext[stone]
+			if stone == pos {
+				break
+			}
+		}
+		if (color == config.BLACK && owned < 0.0) || (color == config.WHITE && owned > 0.0) {
+			game.flagChain(pos, dead)
+		}
+	}
+	return
+}
+
+// estimatesDeadStones says whether scoring removes the estimated dead stones,
+// either by the dead stone rule or because the game reached the maximum number of moves
+func (game *Game) estimatesDeadStones() bool {
+	return game.options.DeadStones == ESTIMATE_DEAD_STONES ||
+		(game.Truncated() && game.options.MaxMovesScoring == SCORE_ESTIMATED)
+}
+
+// needsCleanup says whether some stone on the board is not pass-alive, that is, not unconditionally alive by Benson's algorithm
+func (game *Game) needsCleanup() bool {
+	alive := make([]bool, len(game.board))
+	dead := make([]bool, len(game.board))
+	for _, color := range [2]int{config.BLACK, config.WHITE} {
+		game.unconditionalLife(color, alive, dead)
+	}
+	for pos, color := range game.board {
+		if color != EMPTY && !alive[pos] {
+			return true
+		}
+	}
+	return false
+}
+
+// flagChain sets the flags of all stones of the chain with the given representative position
+func (game *Game) flagChain(head int, flags []bool) {
+	pos := head
+	for {
+		flags[pos] = true
+		pos = game.chainNext[pos]
+		if pos == head {
+			break
+		}
+	}
+}
+
+/**
+	unconditionalLife runs Benson's algorithm for the chains of the given color
+	it flags the stones of the unconditionally alive chains as alive,
+	and the opponent stones inside the vital regions of those chains as dead
+*/
+func (game *Game) unconditionalLife(color int, alive []bool, dead []bool) {
+	// the regions are the connected areas of positions without stones of this color
+	regionOf := make([]int, len(game.board))
+	for pos := range regionOf {
+		regionOf[pos] = UNDEF
+	}
+	var regions [][]int
+	for start, startColor := range game.board {
+		if startColor == color || regionOf[start] != UNDEF {
+			continue
+		}
+		region := []int{start}
+		regionOf[start] = len(regions)
+		for r := 0; r < len(region); r++ {
+			for _, neigh := range game.adjacent[region[r]] {
+				if game.board[neigh] != color && regionOf[neigh] == UNDEF {
+					regionOf[neigh] = len(regions)
+					region = append(region, neigh)
+				}
+			}
+		}
+		regions = append(regions, region)
+	}
+
+	// a region is vital to a chain if all its empty positions are liberties of that chain
+	bordering := make([]map[int]bool, len(regions)) // the chains around each region
+	vital := make([]map[int]bool, len(regions))
+	for r, region := range regions {
+		bordering[r] = make(map[int]bool)
+		for _, pos := range region {
+			for _, neigh := range game.adjacent[pos] {
+				if game.board[neigh] == color {
+					bordering[r][game.chainHead[neigh]] = true
+				}
+			}
+		}
+		vital[r] = make(map[int]bool)
+		for head := range bordering[r] {
+			vital[r][head] = true
+		}
+		for _, pos := range region {
+			if game.board[pos] != EMPTY {
+				continue
+			}
+			for head := range vital[r] {
+				isLiberty := false
+				for _, neigh := range game.adjacent[pos] {
+					if game.board[neigh] == color && game.chainHead[neigh] == head {
+						isLiberty = true
+					}
+				}
+				if !isLiberty {
+					delete(vital[r], head)
+				}
+			}
+		}
+	}
+
+	// repeatedly drop the chains with fewer than two vital regions, and the regions bordering dropped chains
+	chains := make(map[int]bool)
+	for pos, posColor := range game.board {
+		if posColor == color && game.chainHead[pos] == pos {
+			chains[pos] = true
+		}
+	}
+	healthy := make([]bool, len(regions))
+	for changed := true; changed; {
+		changed = false
+		for r := range regions {
+			healthy[r] = true
+			for head := range bordering[r] {
+				if !chains[head] {
+					healthy[r] = false
+				}
+			}
+		}
+		for head := range chains {
+			numVital := 0
+			for r := range regions {
+				if healthy[r] && vital[r][head] {
+					numVital++
+				}
+			}
+			if numVital < 2 {
+				delete(chains, head)
+				changed = true
+			}
+		}
+	}
+
+	for head := range chains {
+		game.flagChain(head, alive)
+	}
+	for r, region := range regions {
+		if !healthy[r] || len(vital[r]) == 0 || len(bordering[r]) == 0 {
+			continue
+		}
+		for _, pos := range region {
+			if game.board[pos] != EMPTY {
+				dead[pos] = true
+			}
+		}
+	}
+}
+
+/**
+	inSeki says whether the chain with the given representative position is in seki:
+	it has at least two liberties, it shares a liberty with an opponent chain,
+	and playing on any shared liberty would put the player's own chain into atari without capturing
+*/
+func (game *Game) inSeki(head int) bool {
+	color := game.board[head]
+	if color == EMPTY || game.chainLiberties[head] < 2 {
+		return false
+	}
+	liberties := game.chainLibertyList(head)
+	shared := false
+	for _, liberty := range liberties {
+		sharedLiberty := false
+		for _, neigh := range game.adjacent[liberty] {
+			if game.board[neigh] == other(color) {
+				sharedLiberty = true
+			}
+		}
+		if !sharedLiberty {
+			continue
+		}
+		shared = true
+		for _, player := range [2]int{config.BLACK, config.WHITE} {
+			numLiberties, captures := game.libertiesAfter(liberty, player)
+			if captures || numLiberties > 1 {
+				return false
+			}
+		}
+	}
+	return shared
+}
+
+// chainLibertyList lists the liberties of the chain with the given representative position
+func (game *Game) chainLibertyList(head int) (liberties []int) {
+	mark := game.newMark()
+	pos := head
+	for {
+		for _, neigh := range game.adjacent[pos] {
+			if game.board[neigh] == EMPTY && game.marks[neigh] != mark {
+				game.marks[neigh] = mark
+				liberties = append(liberties, neigh)
+			}
+		}
+		pos = game.chainNext[pos]
+		if pos == head {
+			break
+		}
+	}
+	return
+}
+
+// libertiesAfter counts the liberties a stone of the given color at the empty position pos would have,
+// and says whether that stone would capture something
+func (game *Game) libertiesAfter(pos int, color int) (liberties int, captures bool) {
+	mark := game.newMark()
+	game.marks[pos] = mark
+	for _, neigh := range game.adjacent[pos] {
+		switch game.board[neigh] {
+		case EMPTY:
+			if game.marks[neigh] != mark {
+				game.marks[neigh] = mark
+				liberties++
+			}
+		case color:
+			// the liberties of a friendly chain become liberties of the new stone
+			head := game.chainHead[neigh]
+			stone := head
+			for {
+				for _, libertyCandidate := range game.adjacent[stone] {
+					if game.board[libertyCandidate] == EMPTY && game.marks[libertyCandidate] != mark {
+						game.marks[libertyCandidate] = mark
+						liberties++
+					}
+				}
+				stone = game.chainNext[stone]
+				if stone == head {
+					break
+				}
+			}
+		default:
+			if game.chainLiberties[game.chainHead[neigh]] == 1 {
+				captures = true
+			}
+		}
+	}
+	return
+}
+
+/**
+	playoutOwnership plays the given number of random games to the end from the current position
+	and averages, for every position, 1 if Black owns it at the end and -1 if White does
+	the playouts neither fill their own eyes nor pass while they have another action, and they are seeded by the position,
+	so the same position always gets the same ownership
+*/
+func (game *Game) playoutOwnership(playouts int) []float32 {
+	ownership := make([]float32, len(game.board))
+	if playouts <= 0 {
+		return ownership
+	}
+	random := rand.New(rand.NewSource(int64(game.hash)))
+	pass := game.PassAction()
+	for p := 0; p < playouts; p++ {
+		playout := game.CopyWithoutHistory()
+		if playout.lastPass {
+			// the playouts go on even if both players have passed
+			playout.lastPass = false
+			playout.hash ^= zobristLastPass
+		}
+		// whatever the options' filters and limits, the playouts never fill their own eyes and always may pass
+		playout.options.ActionFilters = OWN_EYE_FILTER
+		playout.options.MaxMoves = 0
+		playout.options.NoPassMoves = 0
+		playout.options.Cleanup = NO_CLEANUP
+		playout.clearLegalActions()
+		playout.updateLegalActions()
+		for moves := 0; !playout.Finished() && moves < 3 * len(game.board); moves++ {
+			legalActions := playout.favourableLegalActions
+			action := pass
+			if len(legalActions) > 1 {
+				// the pass action comes last
+				action = legalActions[random.Intn(len(legalActions)-1)]
+			}
+			playout.Step(action)
+		}
+		owners, _ := playout.regions(playout.board, nil)
+		for pos, owner := range owners {
+			switch owner {
+			case config.BLACK:
+				ownership[pos] += 1.0
+			case config.WHITE:
+				ownership[pos] -= 1.0
+			}
+		}
+	}
+	for pos := range ownership {
+		ownership[pos] /= float32(playouts)
+	}
+	return ownership
+}
+
+// DeadStones lists the stones that scoring removes from the board, which are none unless the options estimate them
+func (game *Game) DeadStones() []int {
+	dead := make([]int, 0)
+	if !game.estimatesDeadStones() {
+		return dead
+	}
+	flags, _ := game.deadStones()
+	for pos, isDead := range flags {
+		if isDead {
+			dead = append(dead, pos)
+		}
+	}
+	return dead
+}
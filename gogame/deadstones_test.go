@@ -0,0 +1,141 @@
+package gogame
+
+import (
+	"testing"
+	"gitlab.com/Habimm/tree-search-golang/config"
+)
+
+// rowsSetup reads a setup from rows of X for black stones, O for white stones and - for empty points
+func rowsSetup(rows []string) (options Options, setup Setup) {
+	options = DefaultOptions()
+	options.Width, options.Height = len(rows[0]), len(rows)
+	options.Komi = 0.5
+	for row, line := range rows {
+		for column, point := range line {
+			switch point {
+			case 'X':
+				setup.Black = append(setup.Black, options.Point(row, column))
+			case 'O':
+				setup.White = append(setup.White, options.Point(row, column))
+			}
+		}
+	}
+	return
+}
+
+// a white stone sits in Black's area and a black stone in White's area
+var earlyPassRows = []string{
+	"----XO---",
+	"----XO---",
+	"-O--XO---",
+	"----XO---",
+	"----XO---",
+	"----XO-X-",
+	"----XO---",
+	"----XO---",
+	"----XO---"}
+
+func TestEarlyPassDeadStones(t *testing.T) {
+	options, setup := rowsSetup(earlyPassRows)
+	options.DeadStones = ESTIMATE_DEAD_STONES
+	game := NewFromSetup(options, setup)
+	game.Step(game.PassAction())
+	game.Step(game.PassAction())
+
+	dead := game.DeadStones()
+	if len(dead) != 2 || !contains(dead, options.Point(2, 1)) || !contains(dead, options.Point(5, 7)) {
+		t.Errorf("Dead stones are %v instead of the two stones behind the walls", dead)
+	}
+	// Black has 9 wall stones and 36 points of area, White 9 wall stones, 27 points and the komi
+	if game.Score() != 8.5 {
+		t.Errorf("Score with estimated dead stones is %.1f instead of 8.5", game.Score())
+	}
+
+	options.DeadStones = ALL_ALIVE
+	game = NewFromSetup(options, setup)
+	if game.Score() != -0.5 || len(game.DeadStones()) != 0 {
+		t.Errorf("Score with all stones alive is %.1f instead of -0.5", game.Score())
+	}
+}
+
+func TestSeki(t *testing.T) {
+	// the black chain at the top and the white chain next to it share their only two liberties,
+	// and both are surrounded by chains with two eyes
+	options, setup := rowsSetup([]string{
+		"-OOX-OXX-",
+		"OOOX-OXXX",
+		"-OOXXOXX-",
+		"OOOOOXXXX",
+		"OOOOOXXXX"})
+	options.DeadStones = ESTIMATE_DEAD_STONES
+	for _, rules := range []Rules{TROMP_TAYLOR, JAPANESE} {
+		options.Rules = rules
+		game := NewFromSetup(options, setup)
+		dead, seki := game.deadStones()
+		for pos, color := range game.board {
+			if dead[pos] {
+				t.Errorf("Stone %d is dead", pos)
+			}
+			row, column := options.Coordinates(pos)
+			inSeki := (color == config.BLACK && ((column == 3 && row < 3) || pos == options.Point(2, 4))) ||
+				(color == config.WHITE && column == 5)
+			if seki[pos] != inSeki {
+				t.Errorf("Seki flag of position %d is %t", pos, seki[pos])
+			}
+		}
+	}
+
+	// the shared liberties are nobody's
+	options.Rules = TROMP_TAYLOR
+	game := NewFromSetup(options, setup)
+	black, white := 0, 0
+	for _, color := range game.board {
+		switch color {
+		case config.BLACK:
+			black++
+		case config.WHITE:
+			white++
+		}
+	}
+	expected := float32(black + 2) - float32(white + 2) - options.Komi
+	if game.Score() != expected {
+		t.Errorf("Score of the seki position is %.1f instead of %.1f", game.Score(), expected)
+	}
+}
+
+func TestOwnership(t *testing.T) {
+	options, setup := rowsSetup([]string{
+		"--XO-",
+		"-OXO-",
+		"--XO-"})
+	corner, center := options.Point(0, 0), options.Point(1, 2)
+	game := NewFromSetup(options, setup)
+	owners, regionSizes := game.Ownership()
+	if owners[corner] != EMPTY || regionSizes[corner] != 5 || owners[options.Point(0, 4)] != config.WHITE ||
+		regionSizes[options.Point(0, 4)] != 3 || owners[center] != config.BLACK || regionSizes[center] != 0 {
+		t.Errorf("All alive ownership is %v with region sizes %v", owners, regionSizes)
+	}
+
+	// once the lone stones behind the walls are dead, each wall owns the whole area behind it
+	options, setup = rowsSetup(earlyPassRows)
+	options.DeadStones = ESTIMATE_DEAD_STONES
+	game = NewFromSetup(options, setup)
+	owners, regionSizes = game.Ownership()
+	for _, expected := range []struct{ pos, owner, regionSize int }{
+		{options.Point(0, 0), config.BLACK, 36},
+		{options.Point(2, 1), config.BLACK, 36},
+		{options.Point(5, 7), config.WHITE, 27},
+		{options.Point(0, 4), config.BLACK, 0}} {
+		if owners[expected.pos] != expected.owner || regionSizes[expected.pos] != expected.regionSize {
+			t.Errorf("Position %d is owned by %d in a region of size %d instead of by %d in one of size %d",
+				expected.pos, owners[expected.pos], regionSizes[expected.pos], expected.owner, expected.regionSize)
+		}
+	}
+
+	// without estimating, the lone stones stay alive and make the areas behind the walls nobody's
+	owners, _ = game.AliveOwnership()
+	if owners[options.Point(2, 1)] != config.WHITE || owners[options.Point(0, 0)] != EMPTY {
+		t.Errorf("With every stone alive, the lone stone is owned by %d and the corner by %d",
+			owners[options.Point(2, 1)], owners[options.Point(0, 0)])
+	}
+}
@@ -0,0 +1,295 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"sync/atomic"
+)
+
+/**
+	a feature fills some consecutive channels of every position of an observation
+	numPlanes says how many channels it fills for the given options
+	fill writes the channels of one position, which all start out 0
+*/
+type feature struct {
+	numPlanes	func(options Options) int
+	fill		func(game *Game, context *observationContext, pos int, planes []float32)
+}
+
+// FEATURES are all features an observation can be made of, by name
+var FEATURES = map[string]feature{
+	// the stones of the player to move and of the opponent, for the current and the HistorySize-1 previous boards
+	"stones": {
+		numPlanes: func(options Options) int { return options.HistorySize * 2 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			channel := 0
+			for _, board := range context.boards {
+				for _, color := range [2]int{game.currentColor, other(game.currentColor)} {
+					if board[pos] == color {
+						planes[channel] = float32(1.0)
+					}
+					channel++
+				}
+			}
+		}},
+
+	// set everywhere if white is to move
+	"color": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if game.currentColor == config.WHITE {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the stones of the player to move whose chain has 1, 2 or at least 3 liberties, then those of the opponent
+	"liberties": {
+		numPlanes: func(options Options) int { return 6 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			color := game.board[pos]
+			if color == EMPTY {
+				return
+			}
+			channel := game.chainLiberties[game.chainHead[pos]] - 1
+			if channel > 2 {
+				channel = 2
+			}
+			if color != game.currentColor {
+				channel += 3
+			}
+			planes[channel] = float32(1.0)
+		}},
+
+	// the empty points where the ko rule forbids the player to move to play
+	"ko": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if context.isKoForbidden(game, pos) {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the point of the last action, unless it was a pass
+	"last_move": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if len(game.differences) > 0 && game.differences[len(game.differences)-1].action == pos {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the empty points where the player to move captures opponent stones
+	"captures": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if game.board[pos] == EMPTY && game.touchesEnemyChain(pos, 1) {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the empty points where the player to move puts opponent stones into atari
+	"atari": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if game.board[pos] == EMPTY && game.touchesEnemyChain(pos, 2) {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the favourable legal board actions of the player to move
+	"legal": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			if context.isLegal(game, pos) {
+				planes[0] = float32(1.0)
+			}
+		}},
+
+	// the stones of chains with one or two liberties that are captured in a ladder,
+	// then the moves with which the player to move captures an opponent chain in a ladder
+	"ladder": {
+		numPlanes: func(options Options) int { return 2 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			context.readLadders(game)
+			if context.laddered[pos] {
+				planes[0] = float32(1.0)
+			}
+			if context.ladderMoves[pos] {
+				planes[1] = float32(1.0)
+			}
+		}},
+
+	// the komi per board point, positive if it favours the player to move
+	"komi": {
+		numPlanes: func(options Options) int { return 1 },
+		fill: func(game *Game, context *observationContext, pos int, planes []float32) {
+			komi := game.options.Komi / float32(len(game.board))
+			if game.currentColor == config.BLACK {
+				komi = -komi
+			}
+			planes[0] = komi
+		}},
+}
+
+// FEATURE_SETS name the lists of features an observation can be made of, in channel order
+var FEATURE_SETS = map[string][]string{
+	"basic": {"stones", "color"},
+	"extended": {"stones", "color", "liberties", "ko", "last_move", "captures", "atari", "legal", "komi"},
+	"extended_ladders": {"stones", "color", "liberties", "ko", "last_move", "captures", "atari", "legal", "komi", "ladder"},
+}
+
+// features looks up the features of the options' feature set
+func (options Options) features() []feature {
+	names, present := FEATURE_SETS[options.FeatureSet]
+	if !present {
+		log.Panicf("Unknown feature set %s", options.FeatureSet)
+	}
+	features := make([]feature, len(names))
+	for f, name := range names {
+		features[f] = FEATURES[name]
+	}
+	return features
+}
+
+// ObservationSize is the number of floats of an observation, which is NumFeatures for every point
+func (options Options) ObservationSize() int {
+	return options.NumPoints() * options.NumFeatures()
+}
+
+// NumFeatures is the number of channels of every position of an observation
+func (options Options) NumFeatures() int {
+	numFeatures := 0
+	for _, feature := range options.features() {
+		numFeatures += feature.numPlanes(options)
+	}
+	return numFeatures
+}
+
+// observationContext holds what features need to know about the whole game, computed once per observation
+type observationContext struct {
+	boards		[][]int // the current board followed by the previous boards, HistorySize in total
+	legal		[]bool // computed on first use
+	koForbidden	[]bool // computed on first use
+	laddered	[]bool // computed on first use, together with ladderMoves
+	ladderMoves	[]bool
+}
+
+func (game *Game) newObservationContext() *observationContext {
+	context := new(observationContext)
+	context.boards = make([][]int, 1, game.options.HistorySize)
+	context.boards[0] = game.board
+	context.boards = game.appendHistoryBoards(context.boards)
+	return context
+}
+
+// historyRing holds the buffers of the previous boards
+// a copy of the game shares them until one of the two steps, which then makes its own ring
+type historyRing struct {
+	boards	[][]int
+	shared	int32 // accessed atomically, since several goroutines may copy the same game
+}
+
+func (ring *historyRing) share() {
+	atomic.StoreInt32(&ring.shared, 1)
+}
+
+// appendHistoryBoards appends the HistorySize-1 previous boards, the latest first, and creates them if Step has not kept them
+func (game *Game) appendHistoryBoards(boards [][]int) [][]int {
+	if game.history == nil {
+		// create full boards for all memorized differences
+		ring := &historyRing{boards: make([][]int, game.options.HistorySize-1)}
+		board := game.board
+		for t := range ring.boards {
+			ring.boards[t] = make([]int, len(board))
+			copy(ring.boards[t], board)
+			game.applyDiff(ring.boards[t], t)
+			board = ring.boards[t]
+		}
+		game.history = ring
+		game.historyLatest = 0
+	}
+	ring := game.history.boards
+	for t := range ring {
+		boards = append(boards, ring[(game.historyLatest + t) % len(ring)])
+	}
+	return boards
+}
+
+// pushHistory makes the current board the latest previous board in place of the oldest one, before Step changes it
+func (game *Game) pushHistory() {
+	ring := game.history.boards
+	if len(ring) == 0 {
+		return
+	}
+	if atomic.LoadInt32(&game.history.shared) != 0 {
+		// leave the shared buffers to the copies
+		own := &historyRing{boards: make([][]int, len(ring))}
+		for t := range ring {
+			own.boards[t] = make([]int, len(ring[t]))
+			copy(own.boards[t], ring[t])
+		}
+		game.history = own
+		ring = own.boards
+	}
+	game.historyLatest = (game.historyLatest + len(ring) - 1) % len(ring)
+	copy(ring[game.historyLatest], game.board)
+}
+
+func (context *observationContext) isLegal(game *Game, pos int) bool {
+	if context.legal == nil {
+		context.legal = make([]bool, len(game.board))
+		for _, action := range game.favourableLegalActions {
+			if action != game.PassAction() {
+				context.legal[action] = true
+			}
+		}
+	}
+	return context.legal[pos]
+}
+
+func (context *observationContext) isKoForbidden(game *Game, pos int) bool {
+	if context.koForbidden == nil {
+		context.koForbidden = make([]bool, len(game.board))
+		if !game.Finished() {
+			for action, color := range game.board {
+				if color == EMPTY && game.repeatsPosition(action) {
+					context.koForbidden[action] = true
+				}
+			}
+		}
+	}
+	return context.koForbidden[pos]
+}
+
+func (context *observationContext) readLadders(game *Game) {
+	if context.laddered != nil {
+		return
+	}
+	context.laddered = make([]bool, len(game.board))
+	context.ladderMoves = make([]bool, len(game.board))
+	for pos, color := range game.board {
+		if color == EMPTY || game.chainHead[pos] != pos || game.chainLiberties[pos] > 2 {
+			continue
+		}
+		captured, moves := game.Ladder(pos)
+		if !captured {
+			continue
+		}
+		game.flagChain(pos, context.laddered)
+		if color != game.currentColor {
+			for _, move := range moves {
+				context.ladderMoves[move] = true
+			}
+		}
+	}
+}
+
+// touchesEnemyChain says whether a stone at pos would touch an opponent chain that has the given number of liberties
+func (game *Game) touchesEnemyChain(pos int, liberties int) bool {
+	otherColor := other(game.currentColor)
+	for _, neigh := range game.adjacent[pos] {
+		if game.board[neigh] == otherColor && game.chainLiberties[game.chainHead[neigh]] == liberties {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package gogame
+
+import (
+	"testing"
+	"sort"
+	"fmt"
+	"math/rand"
+)
+
+func TestBasicFeatures(t *testing.T) {
+	options := DefaultOptions()
+	options.FeatureSet = "basic"
+	if options.NumFeatures() != options.HistorySize * 2 + 1 {
+		t.Errorf("Basic feature set has %d channels instead of %d", options.NumFeatures(), options.HistorySize * 2 + 1)
+	}
+}
+
+func TestExtendedFeatures(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	options.FeatureSet = "extended"
+	game := New(options)
+	// black captures the white stone at 6 and white may not take the ko back
+	for _, action := range []int{1, 6, 5, 2, 11, 8, 20, 12, 7} {
+		game.Step(action)
+	}
+
+	// expected lists, for every plane of some features, the positions where it is set
+	expected := map[string][][]int{
+		"liberties": {{2}, {12}, {8}, {7}, {1, 20}, {5, 11}},
+		"ko": {{6}},
+		"last_move": {{7}},
+		"captures": {{6}},
+		"atari": {{0, 6, 15, 21}},
+		"legal": {{3, 4, 9, 10, 13, 14, 15, 16, 17, 18, 19, 21, 22, 23, 24}},
+	}
+	observation := game.Observation()
+	channel := 0
+	for _, name := range FEATURE_SETS[options.FeatureSet] {
+		numPlanes := FEATURES[name].numPlanes(options)
+		for plane, positions := range expected[name] {
+			set := make([]int, 0)
+			for pos := 0; pos < len(game.board); pos++ {
+				if observation[pos / options.Width][pos % options.Width][channel+plane] != 0.0 {
+					set = append(set, pos)
+				}
+			}
+			sort.Ints(positions)
+			if fmt.Sprint(set) != fmt.Sprint(positions) {
+				t.Errorf("Plane %d of feature %s is set at %v instead of %v", plane, name, set, positions)
+			}
+		}
+		if name == "komi" && observation[0][0][channel] != options.Komi / 25 {
+			t.Errorf("Komi plane is %f instead of %f", observation[0][0][channel], options.Komi / 25)
+		}
+		channel += numPlanes
+	}
+	if channel != options.NumFeatures() || len(observation[0][0]) != channel {
+		t.Errorf("Observation has %d channels, but the features fill %d", len(observation[0][0]), channel)
+	}
+}
+
+func TestIncrementalObservation(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	options.FeatureSet = "extended"
+	actions := SgfActions("sgf/proper5GameWithComplexOutcome.sgf", options)
+
+	// fromScratch creates the observation without the history that Step keeps
+	fromScratch := func(game *Game) string {
+		gameCopy := game.Copy()
+		gameCopy.history = nil
+		return fmt.Sprint(gameCopy.Observation())
+	}
+	game := New(options)
+	buffer := make([]float32, options.ObservationSize())
+	for a, action := range actions {
+		game.Observation()
+		game.Step(action)
+		if game.history == nil || len(game.history.boards) != options.HistorySize-1 {
+			t.Fatalf("Step did not keep the history after %d actions", a+1)
+		}
+		observation := game.Observation()
+		if fmt.Sprint(observation) != fromScratch(game) {
+			t.Fatalf("Incremental observation differs from the one from scratch after %d actions", a+1)
+		}
+
+		game.ObservationInto(buffer)
+		flat := make([]float32, 0, len(buffer))
+		for _, row := range observation {
+			for _, channels := range row {
+				flat = append(flat, channels...)
+			}
+		}
+		if fmt.Sprint(buffer) != fmt.Sprint(flat) {
+			t.Fatalf("ObservationInto differs from the flattened observation after %d actions", a+1)
+		}
+
+		// a copy that steps on does not change the history of the original
+		gameCopy := game.Copy()
+		for _, legal := range gameCopy.FavourableLegalActions() {
+			gameCopy.Step(legal)
+			break
+		}
+		if fmt.Sprint(game.Observation()) != fmt.Sprint(observation) {
+			t.Fatalf("Stepping a copy changed the observation of the original after %d actions", a+1)
+		}
+
+		// nor does the original that steps on change the history of a copy
+		gameCopy = game.Copy()
+		if a+1 < len(actions) {
+			game.Step(actions[a+1])
+			if fmt.Sprint(gameCopy.Observation()) != fmt.Sprint(observation) {
+				t.Fatalf("Stepping the original changed the observation of a copy after %d actions", a+1)
+			}
+			game.Undo()
+		}
+	}
+	for game.NumMoves() > 0 {
+		game.Undo()
+		if fmt.Sprint(game.Observation()) != fromScratch(game) {
+			t.Fatalf("Observation after taking back actions is wrong with %d actions left", game.NumMoves())
+		}
+	}
+}
+
+func BenchmarkObservationInto(b *testing.B) {
+	options := DefaultOptions()
+	options.Width, options.Height = 9, 9
+	options.FeatureSet = "extended"
+	game := New(options)
+	for _, action := range []int{20, 60, 24, 56, 40} {
+		game.Step(action)
+	}
+	buffer := make([]float32, options.ObservationSize())
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		game.ObservationInto(buffer)
+	}
+}
+
+// BenchmarkStepWithHistory shows that keeping the history costs Step no allocations
+func BenchmarkStepWithHistory(b *testing.B) {
+	options := DefaultOptions()
+	options.Width, options.Height = 9, 9
+	options.MaxMoves = 0
+	random := rand.New(rand.NewSource(1))
+	buffer := make([]float32, options.ObservationSize())
+	var game *Game
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if game == nil || game.Finished() {
+			b.StopTimer()
+			game = New(options)
+			game.ObservationInto(buffer)
+			b.StartTimer()
+		}
+		legalActions := game.FavourableLegalActions()
+		game.Step(legalActions[random.Intn(len(legalActions))])
+	}
+}
@@ -0,0 +1,92 @@
+package gogame
+
+import (
+	"strings"
+)
+
+// ActionFilters is a set of heuristic filters, one bit each, so that options stay comparable with ==
+type ActionFilters uint
+
+const (
+	OWN_EYE_FILTER ActionFilters = 1 << iota
+	SELF_ATARI_FILTER
+	LADDER_ESCAPE_FILTER
+)
+
+// an actionFilter says whether a legal board action is worth considering for the player to move
+// it is given the boardLegality of the action, which updateLegalActions has computed anyway
+type actionFilter func(game *Game, action int, legality Legality) bool
+
+// actionFilters are the filters that options can select by name, in the order of their bits; passing is never filtered
+var actionFilters = []struct {
+	filter	ActionFilters
+	name	string
+	keep	actionFilter
+}{
+	// drop the moves that fill an eye of a single own chain
+	{OWN_EYE_FILTER, "own_eye", func(game *Game, action int, legality Legality) bool {
+		return legality != OWN_EYE
+	}},
+
+	// drop the moves that leave the new stone's chain with a single liberty without capturing anything
+	{SELF_ATARI_FILTER, "self_atari", func(game *Game, action int, legality Legality) bool {
+		liberties, captures := game.libertiesAfter(action, game.currentColor)
+		return captures || liberties > 1
+	}},
+
+	// drop the moves that extend an own chain in atari without capturing, although it is captured in a ladder anyway
+	{LADDER_ESCAPE_FILTER, "ladder_escape", func(game *Game, action int, legality Legality) bool {
+		return !game.ladderEscape(action)
+	}},
+}
+
+// ActionFiltersFromString reads a comma separated list of filter names; the empty string selects no filters
+func ActionFiltersFromString(names string) ActionFilters {
+	filters, unknown := actionFiltersFromNames(strings.Split(names, ","))
+	if unknown != "" {
+		log.Panicf("Unknown action filter %s", unknown)
+	}
+	return filters
+}
+
+// actionFiltersFromNames gives the filters of the names, or the first name that is no filter; blank names are skipped
+func actionFiltersFromNames(names []string) (filters ActionFilters, unknown string) {
+	nameLoop: for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		for _, entry := range actionFilters {
+			if entry.name == name {
+				filters |= entry.filter
+				continue nameLoop
+			}
+		}
+		return filters, name
+	}
+	return filters, ""
+}
+
+// Names lists the names of the filters in the order of their bits
+func (filters ActionFilters) Names() []string {
+	names := make([]string, 0)
+	for _, entry := range actionFilters {
+		if filters & entry.filter != 0 {
+			names = append(names, entry.name)
+		}
+	}
+	return names
+}
+
+// favourable tells whether the legal board action with the given boardLegality passes all filters of the options
+func (game *Game) favourable(action int, legality Legality) bool {
+	if game.options.ActionFilters == 0 {
+		return true
+	}
+	for _, entry := range actionFilters {
+		if game.options.ActionFilters & entry.filter != 0 && !entry.keep(game, action, legality) {
+			return false
+		}
+	}
+	return true
+}
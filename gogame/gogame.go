@@ -5,107 +5,163 @@ import (
 	"github.com/op/go-logging"
 	"fmt"
 	"regexp"
-	"os"
+	"io/ioutil"
 )
 
 var (
 	log = logging.MustGetLogger("gogame")
-	PASS = config.Int["boardsize"] * config.Int["boardsize"]
-	EMPTY = 0 	// EMPTY must get the zero value because a missing position in the board map indicates an empty position
+	EMPTY = 0 	// EMPTY must get the zero value because a new board slice is empty
 	UNDEF = -1
 )
 
 type Game struct {
-	board 		 map[int]int
+	board 		 []int // the color of each position, EMPTY if there is no stone
 
 	/**
-		differences is a ring storing the stones to add or remove to get the previous history_size-1 positions
-		len(differences)-1 is the next index we are going to write to
-		the diff needed to get the previous position is at len(differences)-2
-		the diff needed to get the least recent position recorded is at len(differences)-1
+		chain bookkeeping, kept up to date whenever a stone is placed or captured
+		chainHead[pos] is the representative position of the chain containing the stone at pos (UNDEF if empty)
+		chainNext[pos] is the next stone of that chain; the stones of a chain form a cycle
+		chainSize and chainLiberties are only meaningful at representative positions
+	*/
+	chainHead	 []int
+	chainNext	 []int
+	chainSize	 []int
+	chainLiberties []int
+
+	adjacent	 [][]int // adjacent[pos] lists the neighbours of pos; this table is shared by all games of a size
+
+	/**
+		differences is a stack storing, for every step, the stones to add or remove to get the previous position
+		the diff needed to get the previous position is at len(differences)-1
+		observations look at the topmost HistorySize-1 diffs, while Undo pops them
+		the diffs are never modified, so copies of the game share them
 	*/
 	differences  []boardDifference
 
+	/**
+		history holds the HistorySize-1 previous boards in a ring, or nil until an observation needs them
+		historyLatest is the index of the latest previous board, and the older ones follow it around the ring
+		Step overwrites the oldest board with the current one, while Undo drops the ring
+	*/
+	history		 *historyRing
+	historyLatest int
+
 	currentColor int
 
 	/**
 		an action index (actionIdx) is an index to the favourableLegalActions slice
 		an action (action) is a possible value of that slice
+		legalActions holds every action the rules allow, and favourableLegalActions those that pass the options' filters
 	*/
+	legalActions []int // ordered ascendingly
 	favourableLegalActions []int // ordered ascendingly
 	lastPass	 bool
-}
+	cleanup		 bool // whether the first two passes in a row started the cleanup phase
 
-func New() *Game {
-	board := make(map[int]int)
+	options		 Options
 
-	differences := make([]boardDifference, config.Int["history_size"]-1)
-	for i := range differences {
-		differences[i].rem = UNDEF
-	}
-	differences = differences[:1]
+	// positionHashes holds the superko hashes of all positions reached in this game, including the current one
+	positionHashes []uint64
 
-	numActions := config.Int["boardsize"]*config.Int["boardsize"]+1
-	favourableLegalActions := make([]int, 0, numActions)
-	for a := 0; a < numActions; a++ {
-		favourableLegalActions = append(favourableLegalActions, a)
-	}
+	hash		 uint64
+
+	prisoners	 [3]int // prisoners[color] counts the stones that color has captured
+	handicap	 int
+
+	// marks are scratch space for flagging positions during Step; they are not part of the game state
+	marks		 []int
+	markGen		 int
+}
+
+func New(options Options) *Game {
+	differences := make([]boardDifference, 0, options.HistorySize-1)
 
-	game := &Game{board, differences, config.BLACK, favourableLegalActions, false}
+	game := newEmpty(options, differences)
+	game.positionHashes = []uint64{game.superkoHash(game.boardHash(), game.currentColor)}
+
+	// not every point of the empty board is legal: on a 1x1 board, the only stone would have no liberties
+	game.updateLegalActions()
 	return game
 }
 
 func NewSimple() *Game {
-	board := map[int]int{1:1, 3:2, 5:1, 6:1, 8:2, 9:2, 11:1, 13:2, 15:1, 16:1, 18:2, 19:2}
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	stones := map[int]int{1:1, 3:2, 5:1, 6:1, 8:2, 9:2, 11:1, 13:2, 15:1, 16:1, 18:2, 19:2}
 
+	// these diffs lead to positions before the start of the game, so Undo cannot pop them
 	differences := []boardDifference{
-		{add:map[int]int{}, rem:19},
-		{add:map[int]int{}, rem:5},
-		{add:map[int]int{}, rem:9}}
-	differences = differences[:1]
-
-	numActions := config.Int["boardsize"]*config.Int["boardsize"]+1
-	favourableLegalActions := make([]int, 0, numActions)
-	favourableLegalActions = append(
-		favourableLegalActions,
-		[]int{2, 7, 12, 17, 20, 21, 22, 23, 24, 25}...)
-
-	game := &Game{board, differences, config.BLACK, favourableLegalActions, false}
+		{add:map[int]int{}, rem:19, action:19},
+		{add:map[int]int{}, rem:5, action:5},
+		{add:map[int]int{}, rem:9, action:9}}
+
+	game := newEmpty(options, differences)
+	for pos, color := range stones {
+		game.board[pos] = color
+	}
+	game.rebuildChains()
+	game.hash = game.computeHash()
+	game.positionHashes = []uint64{game.superkoHash(game.boardHash(), game.currentColor)}
+	game.updateLegalActions()
+	return game
+}
+
+// newEmpty creates a game with an empty board, Black to move and no legal actions yet
+func newEmpty(options Options, differences []boardDifference) *Game {
+	// the zobrist keys, like SGF coordinates, cover boards of up to 52x52
+	if options.Width < 1 || options.Height < 1 || options.Width > 52 || options.Height > 52 {
+		log.Panicf("Board size %dx%d is not between 1x1 and 52x52", options.Width, options.Height)
+	}
+	boardLength := options.NumPoints()
+	game := &Game{
+		board: make([]int, boardLength),
+		chainHead: make([]int, boardLength),
+		chainNext: make([]int, boardLength),
+		chainSize: make([]int, boardLength),
+		chainLiberties: make([]int, boardLength),
+		adjacent: adjacencyTable(options.Width, options.Height),
+		differences: differences,
+		currentColor: config.BLACK,
+		legalActions: make([]int, 0, options.NumActions()),
+		favourableLegalActions: make([]int, 0, options.NumActions()),
+		options: options}
+	for pos := range game.chainHead {
+		game.chainHead[pos] = UNDEF
+	}
+	game.hash = game.computeHash()
 	return game
 }
 
 func (game *Game) Step(action int) {
-	diff := boardDifference{add: make(map[int]int), rem: UNDEF}
-	if action != PASS {
+	diff := boardDifference{rem: UNDEF, action: action, lastPass: game.lastPass, cleanup: game.cleanup}
+	pass := game.PassAction()
+	if game.history != nil {
+		game.pushHistory()
+	}
+	if action != pass {
 		if game.board[action] != EMPTY {
 			log.Panicf("Called Step() with action %d which is not empty of color %d", action, game.board[action])
 		}
-		game.board[action] = game.currentColor
-
-		// possibly remove stones of the other color
 		otherColor := other(game.currentColor)
-		neighbours := adjacentPositions(action)
-		for _, neigh := range neighbours {
-			if game.board[neigh] == otherColor {
-				captured := game.capturedStones(neigh)
-
-				// add the captured stones to the diff so we can recover the current position later
-				// and remove the captured stones from the board
-				for cap := range captured {
-					diff.add[cap] = otherColor
-					delete(game.board, cap)
-				}
-			}
-		}
+		enemyCaptured, ownCaptured := game.placeStone(action, game.currentColor, nil, nil)
+		game.hash ^= zobristStones[action][game.currentColor]
 
-		// possibly remove my own stones
-		captured := game.capturedStones(action)
-		for cap := range captured {
+		// add the captured stones to the diff so we can recover the current position later
+		if len(enemyCaptured) + len(ownCaptured) > 0 {
+			diff.add = make(map[int]int, len(enemyCaptured) + len(ownCaptured))
+		}
+		for _, cap := range enemyCaptured {
+			diff.add[cap] = otherColor
+			game.hash ^= zobristStones[cap][otherColor]
+		}
+		game.prisoners[game.currentColor] += len(enemyCaptured)
+		game.prisoners[otherColor] += len(ownCaptured)
+		for _, cap := range ownCaptured {
 			// don't add the new suicidal stone to the previous position because it wasn't there
 			if cap != action {
 				diff.add[cap] = game.currentColor
 			}
-			delete(game.board, cap)
+			game.hash ^= zobristStones[cap][game.currentColor]
 		}
 
 		// if my new stone is still on the board, remember to remove it to get to the previous position
@@ -114,100 +170,145 @@ func (game *Game) Step(action int) {
 		}
 	}
 
-	// store the new boardDifference object into our ring buffer and rotate the latter
-	game.differences[len(game.differences)-1] = diff
-	if len(game.differences) < cap(game.differences) {
-	    game.differences = game.differences[:len(game.differences)+1]
-	} else {
-	    game.differences = game.differences[:1]
-	}
+	game.differences = append(game.differences, diff)
 
 	game.currentColor = other(game.currentColor)
-
-	game.favourableLegalActions = game.favourableLegalActions[:0]
-	if !(action == PASS && game.lastPass) {
-		game.updateLegalActions()
+	game.hash ^= zobristWhiteToMove
+	if game.lastPass != (action == pass) {
+		game.hash ^= zobristLastPass
 	}
-
-	if action == PASS {
+	secondPass := action == pass && game.lastPass
+	if action == pass {
 		game.lastPass = true
 	} else {
 		game.lastPass = false
 	}
+	// only now that lastPass is up to date does boardHash strip the pass key
+	game.positionHashes = append(game.positionHashes, game.superkoHash(game.boardHash(), game.currentColor))
+
+	if secondPass && game.options.Cleanup == PASS_ALIVE_CLEANUP && !game.cleanup && game.needsCleanup() {
+		// the first two passes in a row start the cleanup phase, which the next two passes in a row end
+		game.cleanup = true
+		game.lastPass = false
+		game.hash ^= zobristLastPass
+		secondPass = false
+	}
+
+	game.clearLegalActions()
+	if !secondPass && !game.reachedMaxMoves() {
+		game.updateLegalActions()
+	}
 }
 
-func (game *Game) Score() float32 {
-	// count black and white stones
-	whiteScore, blackScore := float32(0.0), float32(0.0)
-	for _, color := range game.board {
-		switch color {
-		case config.BLACK:
-			blackScore++
-		case config.WHITE:
-			whiteScore++
-		}
+// Undo takes back the last action of this game
+func (game *Game) Undo() {
+	if game.NumMoves() == 0 {
+		log.Panicf("Called Undo() without any action to take back")
 	}
-	log.Debugf("Number of black stones: %.1f\n", blackScore)
-	log.Debugf("Number of white stones: %.1f\n", whiteScore)
-	log.Debugf("Total number of stones: %d\n", len(game.board))
-
-	// initially, all empty fields are known, i.e. it is not determined which color they belong to, if any
-	boardsize := config.Int["boardsize"]
-	boardLength := boardsize * boardsize
-	unknownTerritory := make(map[int]struct{}, boardLength - len(game.board))
-	for a := 0; a < boardLength; a++ {
-		_, present := game.board[a]
-		if !present {
-			unknownTerritory[a] = struct{}{}
+	diff := game.differences[len(game.differences)-1]
+
+	// cap the stacks so that the next Step does not overwrite entries shared with copies of this game
+	game.differences = game.differences[:len(game.differences)-1:len(game.differences)-1]
+	game.positionHashes = game.positionHashes[:len(game.positionHashes)-1:len(game.positionHashes)-1]
+
+	pass := game.PassAction()
+	mover := other(game.currentColor)
+	if diff.action != pass {
+		if diff.rem != UNDEF {
+			game.board[diff.rem] = EMPTY
+			game.hash ^= zobristStones[diff.rem][mover]
+		} else {
+			// the new stone removed itself
+			game.prisoners[game.currentColor]--
+		}
+		for pos, color := range diff.add {
+			game.board[pos] = color
+			game.hash ^= zobristStones[pos][color]
+			game.prisoners[other(color)]--
 		}
+		game.rebuildChains()
 	}
 
-	// go through each unknown position and build its induced connected graph consisting only of empty fields
-	for unknownPos := range unknownTerritory {
+	game.currentColor = mover
+	game.hash ^= zobristWhiteToMove
+	if game.lastPass != diff.lastPass {
+		game.hash ^= zobristLastPass
+	}
+	game.lastPass = diff.lastPass
+	game.cleanup = diff.cleanup
+	game.history = nil
 
-		// this map contains the "outer shell" of the territory we are currently exploring
-		newTerritory := make(map[int]struct{}, 1)
-		newTerritory[unknownPos] = struct{}{}
-		blackTerritory := false
-		whiteTerritory := false
-		count := 0
-		for len(newTerritory) > 0 {
-			for pos := range newTerritory {
-				count++
-				delete(newTerritory, pos)
-				delete(unknownTerritory, pos)
-
-				neighbours := adjacentPositions(pos)
-				for _, neigh := range neighbours {
-					switch game.board[neigh] {
-					case EMPTY:
-						_, present := unknownTerritory[neigh]
-						if present {
-							newTerritory[neigh] = struct{}{}
-						}
-					case config.BLACK:
-						blackTerritory = true
-					case config.WHITE:
-						whiteTerritory = true
-					}
-				}
+	game.refreshLegalActions()
+}
+
+// refreshLegalActions recomputes the legal actions after the position changed other than by Step
+func (game *Game) refreshLegalActions() {
+	game.clearLegalActions()
+	if !game.endedByPasses() && !game.reachedMaxMoves() {
+		game.updateLegalActions()
+	}
+}
+
+// endedByPasses says whether the position was reached by a second pass in a row that ended the game
+func (game *Game) endedByPasses() bool {
+	// the passes that start the cleanup phase reset lastPass
+	return game.lastPass && len(game.differences) > 0 && game.differences[len(game.differences)-1].lastPass
+}
+
+func (game *Game) reachedMaxMoves() bool {
+	return game.options.MaxMoves > 0 && game.NumMoves() >= game.options.MaxMoves
+}
+
+// Truncated says whether the game ended by reaching the maximum number of moves rather than by passing
+func (game *Game) Truncated() bool {
+	return game.reachedMaxMoves() && !game.endedByPasses()
+}
+
+// InCleanup says whether the game is in the cleanup phase that the first two passes in a row start under pass-alive cleanup
+func (game *Game) InCleanup() bool {
+	return game.cleanup
+}
+
+// NumMoves is the number of actions taken in this game, which is how often Undo can be called
+func (game *Game) NumMoves() int {
+	return len(game.positionHashes)-1
+}
+
+// Score is the difference between the current player's points and the other player's points
+// under the rules of this game
+func (game *Game) Score() float32 {
+	if game.Truncated() && game.options.MaxMovesScoring == SCORE_DRAW {
+		return float32(0.0)
+	}
+	board, prisoners, neutral := game.scoringBoard()
+	blackScore, whiteScore := game.territory(board, neutral)
+
+	switch game.options.Rules {
+	case TROMP_TAYLOR, CHINESE:
+		// count black and white stones
+		for _, color := range board {
+			switch color {
+			case config.BLACK:
+				blackScore++
+			case config.WHITE:
+				whiteScore++
 			}
 		}
 
-		if (blackTerritory && !whiteTerritory) {
-			blackScore += float32(count)
-			log.Debugf("The territory induced by %d, sized %d, goes to Black; thus black score is %.1f",
-				unknownPos, count, blackScore)
-		} else if (!blackTerritory && whiteTerritory) {
-			whiteScore += float32(count)
-			log.Debugf("The territory induced by %d, sized %d, goes to White; thus white score is %.1f",
-				unknownPos, count, whiteScore)
-		} else {
-			log.Debugf("The territory induced by %d, sized %d, goes to noone", unknownPos, count)
+		// under Chinese rules, White gets a point for each handicap stone
+		if game.options.Rules == CHINESE {
+			whiteScore += float32(game.handicap)
 		}
+	case JAPANESE:
+		blackScore += float32(prisoners[config.BLACK])
+		whiteScore += float32(prisoners[config.WHITE])
+	default:
+		log.Panicf("Rules are invalid %d", game.options.Rules)
 	}
+	log.Debugf("Black score under %s rules is %.1f", game.options.Rules, blackScore)
+	log.Debugf("White score under %s rules is %.1f", game.options.Rules, whiteScore)
 
-	komi := config.Float["komi"]
+	komi := game.options.Komi
 	whiteScore += komi
 	log.Debugf("Total black score is %.1f", blackScore)
 	log.Debugf("Total white score after komi is %.1f", whiteScore)
@@ -223,6 +324,7 @@ func (game *Game) Score() float32 {
 	}
 }
 
+// Outcome is 1 if the current player has won, -1 if they have lost and 0 if the game is a draw
 func (game *Game) Outcome() float32 {
 	score := game.Score()
 	if score > 0.0 {
@@ -230,118 +332,262 @@ func (game *Game) Outcome() float32 {
 	} else if score < 0.0 {
 		return float32(-1.0)
 	}
-	log.Panicf("Outcome is draw")
-	panic(0)
+	return float32(0.0)
 }
 
-func (game *Game) Observation() [][][]float32 {
-	// create full maps for all memorized differences
-	boards := make([]map[int]int, cap(game.differences)+1)
-	boards[0] = game.board
-	for t := 0; t < len(boards)-1; t++ {
-		boards[t+1] = make(map[int]int, len(boards[t]))
-		for pos, color := range boards[t] {
-			boards[t+1][pos] = color
+// scoringBoard is the board that Score counts, together with the prisoners and the stones whose regions are nobody's
+// with dead stone estimation, the dead stones are taken off the board before counting, as if captured
+func (game *Game) scoringBoard() (board []int, prisoners [3]int, neutral []bool) {
+	board = game.board
+	prisoners = game.prisoners
+	if game.estimatesDeadStones() {
+		var dead, seki []bool
+		dead, seki = game.deadStones()
+		board = make([]int, len(game.board))
+		copy(board, game.board)
+		for pos, isDead := range dead {
+			if isDead {
+				prisoners[other(board[pos])]++
+				board[pos] = EMPTY
+			}
+		}
+
+		// under Japanese rules, the empty points surrounded by stones in seki are nobody's territory
+		if game.options.Rules == JAPANESE {
+			neutral = seki
+		}
+	}
+	return
+}
+
+// territory counts the empty positions of the board that reach only black stones and those that reach only white stones
+// empty positions that reach a neutral stone count for no one; neutral may be nil
+func (game *Game) territory(board []int, neutral []bool) (blackScore float32, whiteScore float32) {
+	owners, _ := game.regions(board, neutral)
+	for pos, color := range board {
+		if color != EMPTY {
+			continue
+		}
+		switch owners[pos] {
+		case config.BLACK:
+			blackScore++
+		case config.WHITE:
+			whiteScore++
 		}
-		game.applyDiff(boards[t+1], t)
-	}
-
-	boardsize := config.Int["boardsize"]
-	observation := make([][][]float32, boardsize)
-	num_channels := len(boards) * 2 + 1
-	action := 0
-	for height := 0; height < boardsize; height++ {
-		observation[height] = make([][]float32, boardsize)
-		for width := 0; width < boardsize; width, action = width+1, action+1 {
-			observation[height][width] = make([]float32, num_channels)
-			channel := 0
-			for brdIdx := 0; brdIdx < len(boards); brdIdx++ {
-				for _, color := range [2]int{game.currentColor, other(game.currentColor)} {
-					if boards[brdIdx][action] == color {
-						observation[height][width][channel] = float32(1.0)
+	}
+	log.Debugf("Black territory is %.1f and white territory is %.1f", blackScore, whiteScore)
+	return
+}
+
+/**
+	regions gives the owner of every position of the board and the size of the empty region around it
+	a stone is owned by its color and its region size is 0
+	an empty region is owned by a color if it reaches stones of only that color, none of them neutral;
+	otherwise its owner is EMPTY
+	neutral may be nil
+*/
+func (game *Game) regions(board []int, neutral []bool) (owners []int, regionSizes []int) {
+	owners = make([]int, len(board))
+	regionSizes = make([]int, len(board))
+	copy(owners, board)
+
+	// go through each empty position not yet explored and build its induced connected graph consisting only of empty fields
+	explored := make([]bool, len(board))
+	region := make([]int, 0, len(board))
+	for unknownPos, color := range board {
+		if color != EMPTY || explored[unknownPos] {
+			continue
+		}
+
+		// region holds the territory we are currently exploring, and its unexplored part is its "outer shell"
+		region = append(region[:0], unknownPos)
+		explored[unknownPos] = true
+		blackTerritory := false
+		whiteTerritory := false
+		for r := 0; r < len(region); r++ {
+			for _, neigh := range game.adjacent[region[r]] {
+				if neutral != nil && neutral[neigh] {
+					// reaching both colors makes the region nobody's
+					blackTerritory, whiteTerritory = true, true
+				}
+				switch board[neigh] {
+				case EMPTY:
+					if !explored[neigh] {
+						explored[neigh] = true
+						region = append(region, neigh)
 					}
-					channel++
+				case config.BLACK:
+					blackTerritory = true
+				case config.WHITE:
+					whiteTerritory = true
 				}
 			}
-			if game.currentColor == config.WHITE {
-				observation[height][width][channel] = float32(1.0)
-			}
+		}
+
+		owner := EMPTY
+		if blackTerritory && !whiteTerritory {
+			owner = config.BLACK
+		} else if whiteTerritory && !blackTerritory {
+			owner = config.WHITE
+		}
+		for _, pos := range region {
+			owners[pos] = owner
+			regionSizes[pos] = len(region)
+		}
+	}
+	return
+}
+
+/**
+	Ownership gives, for every position, the color that Score counts it for, or EMPTY if it counts for no one,
+	and the size of the empty region around it, which is 0 for stones
+	dead stones are owned by the opponent if the options estimate them
+	under Japanese rules only territory scores, but stones are still owned by their color
+*/
+func (game *Game) Ownership() (owners []int, regionSizes []int) {
+	board, _, neutral := game.scoringBoard()
+	return game.regions(board, neutral)
+}
+
+// AliveOwnership is Ownership with every stone alive, which needs no playouts whatever the options say about dead stones
+func (game *Game) AliveOwnership() (owners []int, regionSizes []int) {
+	return game.regions(game.board, nil)
+}
+
+// Observation gives, for every row and column, the channels of all features of the options' feature set
+// all channels are views into a single flat buffer as ObservationInto writes it
+func (game *Game) Observation() [][][]float32 {
+	numFeatures := game.options.NumFeatures()
+	flat := make([]float32, game.options.ObservationSize())
+	game.ObservationInto(flat)
+
+	observation := make([][][]float32, game.options.Height)
+	for row := range observation {
+		observation[row] = make([][]float32, game.options.Width)
+		for column := range observation[row] {
+			start := game.options.Point(row, column) * numFeatures
+			observation[row][column] = flat[start:start+numFeatures:start+numFeatures]
 		}
 	}
 	return observation
 }
 
-func (game *Game) Copy() (gameCopy *Game) {
-	gameCopy = new(Game)
-	gameCopy.board = make(map[int]int, len(game.board))
-	for pos, color := range game.board {
-		gameCopy.board[pos] = color
+// ObservationInto writes the observation into a buffer of ObservationSize floats, row by row, column by column, channel by channel
+// this is how predictors lay out a batch of observations, so they can pass buffers without nested slices
+func (game *Game) ObservationInto(buffer []float32) {
+	if len(buffer) != game.options.ObservationSize() {
+		log.Panicf("Observation buffer has %d floats instead of %d", len(buffer), game.options.ObservationSize())
+	}
+	for i := range buffer {
+		buffer[i] = float32(0.0)
+	}
+	context := game.newObservationContext()
+	features := game.options.features()
+	numFeatures := game.options.NumFeatures()
+	for pos := range game.board {
+		channel := pos * numFeatures
+		for _, feature := range features {
+			numPlanes := feature.numPlanes(game.options)
+			feature.fill(game, context, pos, buffer[channel:channel+numPlanes])
+			channel += numPlanes
+		}
 	}
+}
+
+func (game *Game) Copy() *Game {
+	return game.copy(true)
+}
 
-	// this copies the board differences
-	// it's so complicated because len(differences) is misused as a pointer to the end of the ring buffer
-	wholeLen := cap(game.differences)
-	gameCopy.differences = make([]boardDifference, wholeLen)
-	diffLen := len(game.differences)
-	game.differences = game.differences[:wholeLen]
-	copy(gameCopy.differences, game.differences)
-	game.differences = game.differences[:diffLen]
-	gameCopy.differences = gameCopy.differences[:diffLen]
+// CopyWithoutHistory leaves out the previous boards, so that the copy's Step does not keep them until an observation needs them
+func (game *Game) CopyWithoutHistory() *Game {
+	return game.copy(false)
+}
+
+func (game *Game) copy(withHistory bool) (gameCopy *Game) {
+	gameCopy = new(Game)
+	gameCopy.board = make([]int, len(game.board))
+	copy(gameCopy.board, game.board)
+	gameCopy.chainHead = make([]int, len(game.chainHead))
+	copy(gameCopy.chainHead, game.chainHead)
+	gameCopy.chainNext = make([]int, len(game.chainNext))
+	copy(gameCopy.chainNext, game.chainNext)
+	gameCopy.chainSize = make([]int, len(game.chainSize))
+	copy(gameCopy.chainSize, game.chainSize)
+	gameCopy.chainLiberties = make([]int, len(game.chainLiberties))
+	copy(gameCopy.chainLiberties, game.chainLiberties)
+	gameCopy.adjacent = game.adjacent
+
+	// the copy shares the board differences; capping them makes its next Step append to a fresh array
+	gameCopy.differences = game.differences[:len(game.differences):len(game.differences)]
+	if withHistory && game.history != nil {
+		game.history.share()
+		gameCopy.history = game.history
+		gameCopy.historyLatest = game.historyLatest
+	}
 
 	gameCopy.currentColor = game.currentColor
 
+	gameCopy.legalActions = make([]int, len(game.legalActions))
+	copy(gameCopy.legalActions, game.legalActions)
 	gameCopy.favourableLegalActions = make([]int, len(game.favourableLegalActions))
 	for a, action := range game.favourableLegalActions {
 		gameCopy.favourableLegalActions[a] = action
 	}
 
 	gameCopy.lastPass = game.lastPass
+	gameCopy.cleanup = game.cleanup
+	gameCopy.options = game.options
+
+	gameCopy.positionHashes = game.positionHashes[:len(game.positionHashes):len(game.positionHashes)]
+
+	gameCopy.hash = game.hash
+	gameCopy.prisoners = game.prisoners
+	gameCopy.handicap = game.handicap
 	return
 }
 
 // the returned string always ends in a newline
 func (game *Game) String() (nice string) {
 	// this prepends an internal representation of the game to the output
-	nice += fmt.Sprintf("%+v\n", *game) // dereference game to avoid recursion
+	stones := make(map[int]int)
+	for pos, color := range game.board {
+		if color != EMPTY {
+			stones[pos] = color
+		}
+	}
+	nice += fmt.Sprintf("{board:%v differences:%+v currentColor:%d favourableLegalActions:%v lastPass:%t}\n",
+		stones, game.differences, game.currentColor, game.favourableLegalActions, game.lastPass)
 
 	nice += fmt.Sprintf("Current position:\n")
-	nice += boardString(game.board)
+	nice += boardString(game.board, game.options.Width)
 
 	// copy game.board to oldBoard
-	oldBoard := make(map[int]int, len(game.board))
-	for pos, color := range game.board {
-		oldBoard[pos] = color
-	}
+	oldBoard := make([]int, len(game.board))
+	copy(oldBoard, game.board)
 
 	// display the past couple positions we have memorized
-	// this code is so complicated because game.differences is a slice representing a ring buffer
-	// whose beginning depends on the slice's length
-	historySize := config.Int["history_size"]
-	for i := 0; i < historySize-1; i++ {
+	for i := 0; i < game.options.HistorySize-1; i++ {
 		game.applyDiff(oldBoard, i)
 		nice += fmt.Sprintf("Position %d:\n", i+1)
-		nice += boardString(oldBoard)
+		nice += boardString(oldBoard, game.options.Width)
 	}
 	return
 }
 
-func SgfActions(filename string) []int {
-	PASS = config.Int["boardsize"] * config.Int["boardsize"]
-	sgfMoveRegex := regexp.MustCompile(`;[B,W]\[[a-z]{0,2}\]`)
-	sgfFile, err := os.Open(filename)
-	if err != nil {
-		log.Panicf("Could not open SGF file")
-	}
-	sgfBytes := make([]byte, 2048)
-	count, err := sgfFile.Read(sgfBytes)
+// SgfActions reads the moves of an SGF file as actions on a board of the given options
+func SgfActions(filename string, options Options) []int {
+	sgfMoveRegex := regexp.MustCompile(`;[B,W]\[[a-zA-Z]{0,2}\]`)
+	sgfBytes, err := ioutil.ReadFile(filename)
 	if err != nil {
-		log.Panicf("Could not read from the SGF file")
+		log.Panicf("Could not read from the SGF file %s", filename)
 	}
-	sgfString := string(sgfBytes[:count])
-	sgfActionBars := sgfMoveRegex.FindAllString(sgfString, -1)
+	sgfActionBars := sgfMoveRegex.FindAllString(string(sgfBytes), -1)
 
     actions := make([]int, 0, len(sgfActionBars))
-    alternatingColor := config.BLACK
+    if len(sgfActionBars) == 0 {
+        return actions
+    }
+    alternatingColor := fromSgfColor(sgfActionBars[0][1])
     for _, sgfActionBar := range sgfActionBars {
     	// sanity check
     	color := fromSgfColor(sgfActionBar[1])
@@ -351,10 +597,11 @@ func SgfActions(filename string) []int {
         alternatingColor = other(alternatingColor)
 
     	var action int
-    	if len(sgfActionBar) <= 4 {
-	        action = PASS
+    	if len(sgfActionBar) <= 4 || (sgfActionBar[3:5] == "tt" && options.Width <= 19 && options.Height <= 19) {
+    		// older SGF versions write passes as "tt" on boards up to 19x19
+	        action = options.PassAction()
     	} else {
-	        action = sgfToAction(sgfActionBar[3:5])
+	        action = sgfToAction(sgfActionBar[3:5], options)
     	}
 
         actions = append(actions, action)
@@ -366,93 +613,178 @@ func (game *Game) Color() int {
 	return game.currentColor
 }
 
+func (game *Game) Options() Options {
+	return game.options
+}
+
+func (game *Game) Rules() Rules {
+	return game.options.Rules
+}
+
+func (game *Game) PassAction() int {
+	return game.options.PassAction()
+}
+
+func (game *Game) NumActions() int {
+	return game.options.NumActions()
+}
+
+// Prisoners returns the number of stones the given color has captured
+func (game *Game) Prisoners(color int) int {
+	return game.prisoners[color]
+}
+
+// FavourableLegalActions are the legal actions that pass the options' heuristic filters, which searches choose from
 func (game *Game) FavourableLegalActions() []int {
 	return game.favourableLegalActions
 }
 
+// LegalActions are all actions the rules allow, including the pass action unless the game is finished
+func (game *Game) LegalActions() []int {
+	return game.legalActions
+}
+
 func (game *Game) Finished() bool {
-	return len(game.favourableLegalActions) == 0
+	return len(game.legalActions) == 0
 }
 
 // introduce new game-specific knowledge into the configuration
 func ExtendConfig() {
-	config.Int["num_actions"] = config.Int["boardsize"]*config.Int["boardsize"]+1
+	config.Int["num_actions"] = DefaultOptions().NumActions()
+	config.Int["num_features"] = DefaultOptions().NumFeatures()
+}
+
+// clearLegalActions leaves no legal actions, as in a finished game
+func (game *Game) clearLegalActions() {
+	game.legalActions = game.legalActions[:0]
+	game.favourableLegalActions = game.favourableLegalActions[:0]
 }
 
+// updateLegalActions computes the legal actions and the favourable legal actions, given there are none yet
 func (game *Game) updateLegalActions() {
-	otherColor := other(game.currentColor)
-	boardLength := config.Int["boardsize"] * config.Int["boardsize"]
-	boardLoop: for action := 0; action < boardLength; action++ {
-		// ensure that this intersection is empty
-		if game.board[action] != EMPTY {
-			continue boardLoop
-		}
+	for action := range game.board {
+		legality := game.boardLegality(action)
 
-		// FORBID SUICIDE MOVES
+		// FORBID SUPERKO MOVES
 		/*
-			An empty intersection is a suicide move if and only if after putting my new stone there,
-			- every neighbour is non-empty,
-			- every enemy neighbour chain has at least one liberty, and
-			- my new stone's chain has no liberties.
+			An action is a superko move if and only if the position after it has been reached before
+			in this game. Under situational superko, the player to move is part of the position.
 		*/
-
-		// ensure that all neighbours are non-empty
-		neighbours := adjacentPositions(action)
-		for _, neigh := range neighbours {
-			if game.board[neigh] == EMPTY {
+		if (legality == LEGAL || legality == OWN_EYE) && !game.repeatsPosition(action) {
+			game.legalActions = append(game.legalActions, action)
+			if game.favourable(action, legality) {
 				game.favourableLegalActions = append(game.favourableLegalActions, action)
-				continue boardLoop
 			}
 		}
+		// END FORBID SUPERKO MOVES
+	}
 
-		// ensure that, after this move, no enemy neighbour chain is captured
-		game.board[action] = game.currentColor
-		for _, neigh := range neighbours {
-			if game.board[neigh] == otherColor {
-				captured := game.capturedStones(neigh)
-				if len(captured) > 0 {
-					delete(game.board, action)
-					game.favourableLegalActions = append(game.favourableLegalActions, action)
-					continue boardLoop
-				}
-			}
+	// passing is always legal, except early in the game while some board action is favourable
+	if game.cleanup || game.NumMoves() >= game.options.NoPassMoves || len(game.favourableLegalActions) == 0 {
+		game.legalActions = append(game.legalActions, game.PassAction())
+		game.favourableLegalActions = append(game.favourableLegalActions, game.PassAction())
+	}
+}
+
+// boardLegality checks whether the current player may put a stone on the board action, ignoring superko
+func (game *Game) boardLegality(action int) Legality {
+	// ensure that this intersection is empty
+	if game.board[action] != EMPTY {
+		return OCCUPIED
+	}
+	otherColor := other(game.currentColor)
+
+	// FORBID SUICIDE MOVES
+	/*
+		An empty intersection is a suicide move if and only if after putting my new stone there,
+		- every neighbour is non-empty,
+		- every enemy neighbour chain has at least one liberty, and
+		- my new stone's chain has no liberties.
+	*/
+
+	// ensure that all neighbours are non-empty
+	neighbours := game.adjacent[action]
+	for _, neigh := range neighbours {
+		if game.board[neigh] == EMPTY {
+			return LEGAL
 		}
+	}
 
-		// ensure that the new stone's chain survives
-		captured := game.capturedStones(action)
-		delete(game.board, action)
-		if len(captured) > 0 {
-			continue boardLoop
+	// ensure that, after this move, no enemy neighbour chain is captured, that is, none is in atari
+	for _, neigh := range neighbours {
+		if game.board[neigh] == otherColor && game.chainLiberties[game.chainHead[neigh]] == 1 {
+			return LEGAL
 		}
+	}
 
-		// END FORBID SUICIDE MOVES
+	// ensure that the new stone's chain survives, that is, some friendly neighbour chain has another liberty
+	survives := false
+	for _, neigh := range neighbours {
+		if game.board[neigh] == game.currentColor && game.chainLiberties[game.chainHead[neigh]] > 1 {
+			survives = true
+		}
+	}
+	if !survives {
+		return SUICIDE
+	}
 
-		// FORBID EYE MOVES
-		/*
-			An empty intersection is an eye move if and only if before putting my new stone there,
-			- every neighbour is mine, and
-			- every neighbour is in the same chain.
-		*/
+	// END FORBID SUICIDE MOVES
 
-		// ensure that the new stone's neighbours are of the same color as itself
-		for _, neigh := range neighbours {
-			if game.board[neigh] != game.currentColor {
-				game.favourableLegalActions = append(game.favourableLegalActions, action)
-				continue boardLoop
-			}
+	// FORBID EYE MOVES
+	/*
+		An empty intersection is an eye move if and only if before putting my new stone there,
+		- every neighbour is mine, and
+		- every neighbour is in the same chain.
+	*/
+
+	// ensure that the new stone's neighbours are of the same color as itself
+	for _, neigh := range neighbours {
+		if game.board[neigh] != game.currentColor {
+			return LEGAL
 		}
+	}
 
-		// ensure that the new stone's neighbours are not altogether in one chain
-		sameChain := game.reachable(neighbours[0], neighbours[1:])
-		if sameChain {
-			continue boardLoop
+	// ensure that the new stone's neighbours are not altogether in one chain
+	for _, neigh := range neighbours[1:] {
+		if game.chainHead[neigh] != game.chainHead[neighbours[0]] {
+			return LEGAL
 		}
+	}
 
-		// END FORBID EYE MOVES
+	// END FORBID EYE MOVES
+	return OWN_EYE
+}
 
-		game.favourableLegalActions = append(game.favourableLegalActions, action)
+// repeatsPosition reports whether playing the given board action would recreate an earlier position
+func (game *Game) repeatsPosition(action int) bool {
+	otherColor := other(game.currentColor)
+	boardHash := game.boardHash() ^ zobristStones[action][game.currentColor]
+
+	// the enemy neighbour chains in atari get captured
+	mark := game.newMark()
+	for _, neigh := range game.adjacent[action] {
+		head := game.chainHead[neigh]
+		if game.board[neigh] != otherColor || game.chainLiberties[head] != 1 || game.marks[head] == mark {
+			continue
+		}
+		game.marks[head] = mark
+		pos := head
+		for {
+			boardHash ^= zobristStones[pos][otherColor]
+			pos = game.chainNext[pos]
+			if pos == head {
+				break
+			}
+		}
 	}
-	game.favourableLegalActions = append(game.favourableLegalActions, PASS)
+
+	hash := game.superkoHash(boardHash, otherColor)
+	for _, oldHash := range game.positionHashes {
+		if oldHash == hash {
+			return true
+		}
+	}
+	return false
 }
 
 func other(color int) int {
@@ -477,72 +809,17 @@ func fromSgfColor(c byte) int {
 	panic(0)
 }
 
-// an SGF action consists of two alphabet letters <width><height>, where "aa" indicates the top-left corner
-func sgfToAction(sgfAction string) int {
-    aRune := rune('a')
-    width, height := rune(sgfAction[0]) - aRune, rune(sgfAction[1]) - aRune
-
-    boardsize := config.Int["boardsize"]
-    action := int(height) * boardsize + int(width)
-    return action
-}
-
-func (game *Game) capturedStones(startPosition int) (positions map[int]int) {
-	const (
-		NEW = iota
-		OLD
-	)
-	positions = make(map[int]int)
-	positions[startPosition] = NEW
-	color := game.board[startPosition]
-	addedNew := true
-	for addedNew {
-		addedNew = false
-		for pos, flag := range positions {
-			if flag == OLD { continue }
-			neighbours := adjacentPositions(pos)
-			for _, neigh := range neighbours {
-				if game.board[neigh] == EMPTY {
-					return map[int]int{} // we found a liberty of this chain, so no stone will be removed of this chain
-				} else if game.board[neigh] == color {
-					_, present := positions[neigh]
-					if !present {
-						positions[neigh] = NEW
-						addedNew = true
-					}
-				}
-			}
-			positions[pos] = OLD
-		}
-	}
-	return // we found no liberty, so remove the whole chain
-}
-
-func (game *Game) reachable(startPosition int, targetPositions []int) bool {
-	color := game.board[startPosition]
-	positions := []int{startPosition}
-	reached := 0
-	oldCount := 0
-	for oldCount < len(positions) {
-		for _, pos := range positions[oldCount:] {
-			neighbours := adjacentPositions(pos)
-			for _, neigh := range neighbours {
-				if game.board[neigh] != color {
-					continue
-				} else if !contains(positions, neigh) {
-					if contains(targetPositions, neigh) {
-						reached++
-						if reached == len(targetPositions) {
-							return true
-						}
-					}
-					positions = append(positions, neigh)
-				}
-			}
-			oldCount++
-		}
-	}
-	return false
+// an SGF action consists of two letters <width><height>, where "aa" indicates the top-left corner
+// the letters a to z count the first 26 columns or rows and A to Z the next 26
+func sgfToAction(sgfAction string, options Options) int {
+    return options.Point(sgfCoordinate(sgfAction[1]), sgfCoordinate(sgfAction[0]))
+}
+
+func sgfCoordinate(letter byte) int {
+    if letter >= 'A' && letter <= 'Z' {
+        return int(letter - 'A') + 26
+    }
+    return int(letter - 'a')
 }
 
 func contains(a []int, elem int) bool {
@@ -554,40 +831,14 @@ func contains(a []int, elem int) bool {
 	return false
 }
 
-func adjacentPositions(pos int) (positions []int) {
-	boardsize := config.Int["boardsize"]
-	if pos % boardsize != 0 {
-		left := pos-1
-		positions = append(positions, left)
-	}
-
-	right := pos+1
-	if right % boardsize != 0 {
-		positions = append(positions, right)
-	}
-
-	if pos >= boardsize {
-		above := pos-boardsize
-		positions = append(positions, above)
-	}
-
-	if pos < boardsize*boardsize-boardsize {
-		below := pos+boardsize
-		positions = append(positions, below)
-	}
-	return
-}
-
 // the returned string always ends in a newline
-func boardString(board map[int]int) (nice string) {
-	boardsize := config.Int["boardsize"]
-	boardLength := boardsize * boardsize
-	for field, column := 0, 0; field < boardLength; field++ {
+func boardString(board []int, width int) (nice string) {
+	for field, column := 0, 0; field < len(board); field++ {
 		if board[field] == config.BLACK { nice += "X" }
 		if board[field] == config.WHITE { nice += "O" }
 		if board[field] == EMPTY { nice += "-" }
 
-		if column == boardsize-1 {
+		if column == width-1 {
 			column = 0
 			nice += "\n"
 		} else {
@@ -599,20 +850,28 @@ func boardString(board map[int]int) (nice string) {
 }
 
 type boardDifference struct {
-	add map[int]int
+	add map[int]int // nil if nothing was captured
 	rem int
+
+	action int // the action that was taken from the previous position
+	lastPass bool // whether the previous position was reached by passing
+	cleanup bool // whether the previous position was in the cleanup phase
 }
 
-func (game *Game) applyDiff(board map[int]int, diffIndex int) {
-	allDifferences := game.differences[:cap(game.differences)]
-	index := len(game.differences)-2-diffIndex % len(allDifferences)
-	if index < 0 { index += len(allDifferences) }
-	diff := allDifferences[index]
+// applyDiff turns the board into the position diffIndex+1 steps ago, given it is the position diffIndex steps ago
+// positions before the start of the game are taken to be the starting position
+func (game *Game) applyDiff(board []int, diffIndex int) {
+	index := len(game.differences)-1-diffIndex
+	if index < 0 {
+		return
+	}
+	diff := game.differences[index]
 
 	for pos, color := range diff.add {
 		board[pos] = color
 	}
 	if diff.rem != UNDEF {
-		delete(board, diff.rem)
+		board[diff.rem] = EMPTY
 	}
 }
+
@@ -4,13 +4,18 @@ import (
 	"testing"
 	"os"
 	"fmt"
+	"math/rand"
 	"gitlab.com/Habimm/tree-search-golang/config"
 	"github.com/op/go-logging"
 )
 
-func replayGame(filename string) {
-	legalActions := SgfActions(filename)
-	game := New()
+func replayGame(filename string, boardsize int) {
+	options := DefaultOptions()
+	options.Width, options.Height = boardsize, boardsize
+	// the recorded games are longer than the default max_moves
+	options.MaxMoves = 0
+	legalActions := SgfActions(filename, options)
+	game := New(options)
 	fmt.Printf("%+v", game)
 	fmt.Printf("%+v\n", game.Observation())
 	for _, lAction := range legalActions {
@@ -36,10 +41,11 @@ func TestSetup(t *testing.T) {
 }
 
 func TestGameCopy(t *testing.T) {
-	config.Int["boardsize"] = 5
-	legalActions := SgfActions("sgf/proper5GameWithSimpleOutcome.sgf")
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	legalActions := SgfActions("sgf/proper5GameWithSimpleOutcome.sgf", options)
 
-	game := New()
+	game := New(options)
 	copy := game.Copy()
 	if &copy == &game {
 		t.Errorf("Copy has same address as the original\n")
@@ -74,13 +80,211 @@ func TestGameCopy(t *testing.T) {
 		}
 		game = copy
 	}
+
+	// a copy without the history keeps none while it steps, but still builds the same observation
+	game = New(options)
+	game.Observation()
+	copy = game.CopyWithoutHistory()
+	for _, lAction := range legalActions[:4] {
+		game.Step(lAction)
+		copy.Step(lAction)
+	}
+	if copy.history != nil {
+		t.Errorf("The copy without the history keeps it while it steps")
+	}
+	if obsString, copyObsStr = fmt.Sprint(game.Observation()), fmt.Sprint(copy.Observation()); copyObsStr != obsString {
+		t.Errorf("The copy without the history has the observation %s; the original has %s", copyObsStr, obsString)
+	}
+}
+
+func TestKoRecapture(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 4, 4
+
+	// Black captures the white stone at 5 with 6, so that White's recapture at 5 would repeat a position
+	game := New(options)
+	for _, action := range []int{1, 2, 4, 7, 9, 10, 15, 5, 6} {
+		game.Step(action)
+	}
+	if contains(game.FavourableLegalActions(), 5) {
+		t.Errorf("Immediate ko recapture is legal in\n%s", game)
+	}
+
+	// after an exchange elsewhere, the recapture creates a new position
+	game.Step(12)
+	game.Step(13)
+	if !contains(game.FavourableLegalActions(), 5) {
+		t.Errorf("Ko recapture after an exchange elsewhere is illegal in\n%s", game)
+	}
+}
+
+func TestScoringRules(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 4, 4
+	pass := options.PassAction()
+
+	// Black walls off the left columns and White the right ones; White captures a black stone at 3
+	// and Black wastes a move in its own territory at 0
+	expectedScores := map[Rules]float32{TROMP_TAYLOR: -5.5, CHINESE: -5.5, JAPANESE: -6.5}
+	for rules, expectedScore := range expectedScores {
+		options.Rules = rules
+		game := New(options)
+		for _, action := range []int{1, 2, 5, 6, 9, 10, 13, 14, 3, 7, 0, pass} {
+			game.Step(action)
+		}
+		if game.Prisoners(config.WHITE) != 1 || game.Prisoners(config.BLACK) != 0 {
+			t.Errorf("White has %d and Black has %d prisoners instead of 1 and 0",
+				game.Prisoners(config.WHITE), game.Prisoners(config.BLACK))
+		}
+		if game.Score() != expectedScore {
+			t.Errorf("Score under %s rules is %.1f instead of %.1f", game.Rules(), game.Score(), expectedScore)
+		}
+	}
+}
+
+func TestDrawOutcome(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 4, 4
+	options.Komi = 0.0
+	pass := options.PassAction()
+
+	// Black and White wall off two columns each
+	game := New(options)
+	for _, action := range []int{1, 2, 5, 6, 9, 10, 13, 14, pass, pass} {
+		game.Step(action)
+	}
+	if !game.Finished() || game.Outcome() != 0.0 {
+		t.Errorf("Game is not a finished draw but has outcome %.0f in\n%s", game.Outcome(), game)
+	}
+}
+
+// TestMixedBoardsizes plays random games to the end on square and rectangular boards, up to the width SGF can address
+func TestMixedBoardsizes(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for _, size := range [][2]int{{9, 9}, {7, 5}, {5, 7}, {19, 3}, {2, 11}, {52, 4}, {3, 52}} {
+		width, height := size[0], size[1]
+		options := DefaultOptions()
+		options.Width, options.Height = width, height
+		game := New(options)
+		start := snapshot(game)
+		for !game.Finished() {
+			legalActions := game.FavourableLegalActions()
+			if game.PassAction() != width*height || legalActions[len(legalActions)-1] >= game.NumActions() {
+				t.Fatalf("Game of size %v has pass action %d and legal actions %v", size, game.PassAction(), legalActions)
+			}
+			game.Step(legalActions[random.Intn(len(legalActions))])
+			if game.Hash() != game.computeHash() {
+				t.Fatalf("Game of size %v has the hash %x instead of %x after %d moves", size, game.Hash(), game.computeHash(), game.NumMoves())
+			}
+		}
+		observation := game.Observation()
+		if len(observation) != height || len(observation[0]) != width {
+			t.Errorf("Game of size %v has an observation of size %dx%d", size, len(observation[0]), len(observation))
+		}
+		for game.NumMoves() > 0 {
+			game.Undo()
+		}
+		if snapshot(game) != start {
+			t.Errorf("Taking back the game of size %v does not restore the empty board", size)
+		}
+	}
+}
+
+func TestOversizedBoard(t *testing.T) {
+	for _, size := range [][2]int{{53, 4}, {4, 53}, {0, 5}} {
+		options := DefaultOptions()
+		options.Width, options.Height = size[0], size[1]
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Creating a game of size %v does not panic", size)
+				}
+			}()
+			New(options)
+		}()
+	}
+}
+
+func TestRectangularGame(t *testing.T) {
+	options := SgfOptions("sgf/rectangular7x5Game.sgf", DefaultOptions())
+	if options.Width != 7 || options.Height != 5 || options.Komi != 0.5 {
+		t.Errorf("SGF options have a %dx%d board with komi %.1f", options.Width, options.Height, options.Komi)
+	}
+	game := New(options)
+	for _, action := range SgfActions("sgf/rectangular7x5Game.sgf", options) {
+		game.Step(action)
+	}
+	if !game.Finished() {
+		t.Errorf("Game did not end after two passes")
+	}
+	// both players wall off three columns, so only the komi decides
+	if game.Score() != -0.5 {
+		t.Errorf("Rectangular game has score %.1f instead of -0.5\n%s", game.Score(), game)
+	}
+}
+
+func TestSgfCoordinates(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 52, 40
+	expected := map[string]int{"aa": 0, "za": 25, "Aa": 26, "Za": 51, "ab": 52, "aA": 26*52, "ZN": 39*52+51}
+	for sgfAction, action := range expected {
+		if sgfToAction(sgfAction, options) != action {
+			t.Errorf("SGF action %s is %d instead of %d", sgfAction, sgfToAction(sgfAction, options), action)
+		}
+	}
+}
+
+// snapshot describes everything about the game that Undo should restore
+func snapshot(game *Game) string {
+	return fmt.Sprintf("%v%x %v %v %d %d", game, game.Hash(), game.Observation(), game.Finished(),
+		game.Prisoners(config.BLACK), game.Prisoners(config.WHITE))
+}
+
+func TestUndo(t *testing.T) {
+	sgfBoardsizes := map[string]int{
+		"sgf/proper4Game.sgf": 4,
+		"sgf/proper9Game.sgf": 9,
+		"sgf/allowFakeSuicide.sgf": 5,
+		"sgf/proper5GameWithSimpleOutcome.sgf": 5}
+	for filename, boardsize := range sgfBoardsizes {
+		options := DefaultOptions()
+		options.Width, options.Height = boardsize, boardsize
+		actions := SgfActions(filename, options)
+
+		game := New(options)
+		snapshots := []string{snapshot(game)}
+		copies := []*Game{game.Copy()}
+		for _, action := range actions {
+			game.Step(action)
+			snapshots = append(snapshots, snapshot(game))
+			copies = append(copies, game.Copy())
+		}
+
+		for m := len(actions)-1; m >= 0; m-- {
+			game.Undo()
+			if game.NumMoves() != m {
+				t.Errorf("After undoing to move %d of %s, the game has %d moves", m, filename, game.NumMoves())
+			}
+			if snapshot(game) != snapshots[m] {
+				t.Errorf("Undoing to move %d of %s gives\n%s\ninstead of\n%s", m, filename, snapshot(game), snapshots[m])
+			}
+
+			// stepping differently after an undo must not change the copies taken earlier
+			game.Step(game.PassAction())
+			game.Undo()
+		}
+		for m, gameCopy := range copies {
+			if snapshot(gameCopy) != snapshots[m] {
+				t.Errorf("Copy of move %d of %s changed to\n%s", m, filename, snapshot(gameCopy))
+			}
+		}
+	}
 }
 
 func ExampleGame_proper4Game() {
-	config.Int["boardsize"] = 4
-	replayGame("sgf/proper4Game.sgf")
+	replayGame("sgf/proper4Game.sgf", 4)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - - -
 	// - - - -
@@ -102,7 +306,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[5:1] differences:[{add:map[] rem:5} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
+	// {board:map[5:1] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - - -
 	// - X - -
@@ -124,7 +328,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:2 5:1] differences:[{add:map[] rem:5} {add:map[] rem:2} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 3 4 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
+	// {board:map[2:2 5:1] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 6 7 8 9 10 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - O -
 	// - X - -
@@ -146,7 +350,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:2 5:1 7:1] differences:[{add:map[] rem:5}] currentColor:2 favourableLegalActions:[0 1 3 4 6 8 9 10 11 12 13 14 15 16] lastPass:false}
+	// {board:map[2:2 5:1 7:1] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 6 8 9 10 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - O -
 	// - X - X
@@ -168,7 +372,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:2 5:1 6:2 7:1] differences:[{add:map[] rem:6} {add:map[] rem:2}] currentColor:1 favourableLegalActions:[0 1 3 4 8 9 10 11 12 13 14 15 16] lastPass:false}
+	// {board:map[2:2 5:1 6:2 7:1] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 8 9 10 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - O -
 	// - X O X
@@ -190,7 +394,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:2 5:1 6:2 7:1 10:1] differences:[{add:map[] rem:6} {add:map[] rem:10} {add:map[] rem:7}] currentColor:2 favourableLegalActions:[0 1 3 4 8 9 11 12 13 14 15 16] lastPass:false}
+	// {board:map[2:2 5:1 6:2 7:1 10:1] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 8 9 11 12 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - O -
 	// - X O X
@@ -212,7 +416,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:2 5:1 6:2 7:1 10:1 12:2] differences:[{add:map[] rem:6}] currentColor:1 favourableLegalActions:[0 1 3 4 8 9 11 13 14 15 16] lastPass:false}
+	// {board:map[2:2 5:1 6:2 7:1 10:1 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 8 9 11 13 14 15 16] lastPass:false}
 	// Current position:
 	// - - O -
 	// - X O X
@@ -234,7 +438,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 2:2 5:1 6:2 7:1 10:1 12:2] differences:[{add:map[] rem:1} {add:map[] rem:10}] currentColor:2 favourableLegalActions:[0 4 8 9 11 13 14 15 16] lastPass:false}
+	// {board:map[1:1 2:2 5:1 6:2 7:1 10:1 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 4 8 9 11 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X O -
 	// - X O X
@@ -256,7 +460,7 @@ func ExampleGame_proper4Game() {
 	// - - - -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:1 2:2 5:1 6:2 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:1} {add:map[] rem:11} {add:map[] rem:12}] currentColor:1 favourableLegalActions:[0 3 4 8 9 13 14 15 16] lastPass:false}
+	// {board:map[1:1 2:2 5:1 6:2 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 3 4 8 9 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X O -
 	// - X O X
@@ -278,7 +482,7 @@ func ExampleGame_proper4Game() {
 	// - - X -
 	// - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 3:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:1}] currentColor:2 favourableLegalActions:[0 2 4 6 8 9 13 14 15 16] lastPass:false}
+	// {board:map[1:1 3:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 2 4 6 8 9 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X - X
 	// - X - X
@@ -300,7 +504,7 @@ func ExampleGame_proper4Game() {
 	// - - X -
 	// O - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:2 1:1 3:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:0} {add:map[] rem:11}] currentColor:1 favourableLegalActions:[2 4 6 8 9 13 14 15 16] lastPass:false}
+	// {board:map[0:2 1:1 3:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 4 6 8 9 13 14 15 16] lastPass:false}
 	// Current position:
 	// O X - X
 	// - X - X
@@ -322,7 +526,7 @@ func ExampleGame_proper4Game() {
 	// - - X -
 	// O - - -
 	// [[[0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 3:1 4:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:0} {add:map[0:2] rem:4} {add:map[2:2 6:2] rem:3}] currentColor:2 favourableLegalActions:[2 6 8 9 13 14 15 16] lastPass:false}
+	// {board:map[1:1 3:1 4:1 5:1 7:1 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[2 6 8 9 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X - X
 	// X X - X
@@ -344,7 +548,7 @@ func ExampleGame_proper4Game() {
 	// - - X O
 	// O - - -
 	// [[[0 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2] differences:[{add:map[] rem:0}] currentColor:1 favourableLegalActions:[2 6 8 13 14 15 16] lastPass:false}
+	// {board:map[1:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 6 8 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X - X
 	// X X - X
@@ -366,7 +570,7 @@ func ExampleGame_proper4Game() {
 	// - - X O
 	// O - - -
 	// [[[0 0 0 0 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2] differences:[{add:map[] rem:2} {add:map[0:2] rem:4}] currentColor:2 favourableLegalActions:[8 13 14 15 16] lastPass:false}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[8 13 14 15 16] lastPass:false}
 	// Current position:
 	// - X X X
 	// X X - X
@@ -388,7 +592,7 @@ func ExampleGame_proper4Game() {
 	// - - X O
 	// O - - -
 	// [[[0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2 14:2] differences:[{add:map[] rem:2} {add:map[] rem:14} {add:map[] rem:9}] currentColor:1 favourableLegalActions:[6 8 15 16] lastPass:false}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 9:2 10:1 11:2 12:2 14:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[6 8 15 16] lastPass:false}
 	// Current position:
 	// - X X X
 	// X X - X
@@ -410,7 +614,7 @@ func ExampleGame_proper4Game() {
 	// - - X O
 	// O - - -
 	// [[[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 8:1 9:2 10:1 11:2 12:2 14:2] differences:[{add:map[] rem:2}] currentColor:2 favourableLegalActions:[6 13 15 16] lastPass:false}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 7:1 8:1 9:2 10:1 11:2 12:2 14:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[6 13 15 16] lastPass:false}
 	// Current position:
 	// - X X X
 	// X X - X
@@ -432,7 +636,7 @@ func ExampleGame_proper4Game() {
 	// - O X O
 	// O - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[10:1] rem:6} {add:map[] rem:14}] currentColor:1 favourableLegalActions:[10 13 16] lastPass:false}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[10:1] rem:6 action:6 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[13 16] lastPass:false}
 	// Current position:
 	// - X X X
 	// X X O X
@@ -454,7 +658,7 @@ func ExampleGame_proper4Game() {
 	// - O X O
 	// O - - -
 	// [[[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[10:1] rem:6} {add:map[] rem:-1} {add:map[] rem:8}] currentColor:2 favourableLegalActions:[0 10 13 15 16] lastPass:true}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[10:1] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:-1 action:16 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 10 13 15 16] lastPass:true}
 	// Current position:
 	// - X X X
 	// X X O X
@@ -476,7 +680,7 @@ func ExampleGame_proper4Game() {
 	// - O X O
 	// O - O -
 	// [[[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[10:1] rem:6}] currentColor:1 favourableLegalActions:[] lastPass:true}
+	// {board:map[1:1 2:1 3:1 4:1 5:1 6:2 7:1 8:1 9:2 11:2 12:2 14:2] differences:[{add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[2:2 6:2] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[0:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[10:1] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:-1 action:16 lastPass:false cleanup:false} {add:map[] rem:-1 action:16 lastPass:true cleanup:false}] currentColor:1 favourableLegalActions:[] lastPass:true}
 	// Current position:
 	// - X X X
 	// X X O X
@@ -503,10 +707,9 @@ func ExampleGame_proper4Game() {
 }
 
 func ExampleGame_proper9Game() {
-	config.Int["boardsize"] = 9
-	replayGame("sgf/proper9Game.sgf")
+	replayGame("sgf/proper9Game.sgf", 9)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 39 40 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 39 40 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -548,7 +751,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[40:1] differences:[{add:map[] rem:40} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 39 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 39 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -590,7 +793,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[39:2 40:1] differences:[{add:map[] rem:40} {add:map[] rem:39} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 38 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -632,7 +835,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[38:1 39:2 40:1] differences:[{add:map[] rem:40}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 30 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -674,7 +877,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[30:2 38:1 39:2 40:1] differences:[{add:map[] rem:30} {add:map[] rem:39}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[30:2 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 29 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -716,7 +919,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:30} {add:map[] rem:29} {add:map[] rem:38}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -758,7 +961,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:30}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -800,7 +1003,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[21:1 22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:21} {add:map[] rem:29}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[21:1 22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - - - - - - -
@@ -842,7 +1045,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[12:2 21:1 22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:21} {add:map[] rem:12} {add:map[] rem:22}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 21:1 22:2 29:1 30:2 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 23 24 25 26 27 28 31 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -884,7 +1087,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[12:2 21:1 22:2 29:1 30:2 31:1 38:1 39:2 40:1] differences:[{add:map[] rem:21}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 21:1 22:2 29:1 30:2 31:1 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -926,7 +1129,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1] differences:[{add:map[21:1] rem:20} {add:map[] rem:12}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 48 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -968,7 +1171,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1 48:1] differences:[{add:map[21:1] rem:20} {add:map[] rem:48} {add:map[] rem:31}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 42 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1010,7 +1213,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1 42:2 48:1] differences:[{add:map[21:1] rem:20}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:2 40:1 42:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1052,7 +1255,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 40:1 42:2 48:1] differences:[{add:map[30:2 39:2] rem:21} {add:map[] rem:48}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 39 41 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 40:1 42:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 39 41 43 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1094,7 +1297,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 40:1 42:2 43:2 48:1] differences:[{add:map[30:2 39:2] rem:21} {add:map[] rem:43} {add:map[] rem:42}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 39 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 39 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1136,7 +1339,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[30:2 39:2] rem:21}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 21:1 22:2 29:1 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 30 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1178,7 +1381,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[21:1] rem:30} {add:map[] rem:43}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 23 24 25 26 27 28 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// - - - - - - - - -
 	// - - - O - - - - -
@@ -1220,7 +1423,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[21:1] rem:30} {add:map[] rem:0} {add:map[] rem:39}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 12:2 20:2 22:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 28 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - - O - - - - -
@@ -1262,7 +1465,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 12:2 20:2 22:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[21:1] rem:30}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 12:2 20:2 22:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 21 23 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - - O - - - - -
@@ -1304,7 +1507,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:11} {add:map[] rem:0}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 23 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 23 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1346,7 +1549,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:11} {add:map[] rem:23} {add:map[] rem:28}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 49 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1388,7 +1591,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:11}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 32 33 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1430,7 +1633,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:32} {add:map[] rem:23}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 33 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 33 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1472,7 +1675,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:32} {add:map[] rem:33} {add:map[] rem:49}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1514,7 +1717,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2] differences:[{add:map[] rem:32}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1556,7 +1759,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2 57:1] differences:[{add:map[] rem:57} {add:map[] rem:33}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2 57:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 68 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1598,7 +1801,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:57} {add:map[] rem:68} {add:map[] rem:51}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 46 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1640,7 +1843,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:57}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 34 35 36 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1682,7 +1885,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 34:2 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:34} {add:map[] rem:68}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 35 36 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 34:2 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 35 36 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1724,7 +1927,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - - - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:34} {add:map[] rem:36} {add:map[] rem:46}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 28:2 29:1 30:2 31:1 32:2 33:1 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 24 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1766,7 +1969,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - O - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:34}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 67 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1808,7 +2011,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - O - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2] differences:[{add:map[] rem:67} {add:map[] rem:36}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 33 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 33 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - - - - - - -
 	// - - X O - - - - -
@@ -1850,7 +2053,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - O - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2] differences:[{add:map[] rem:67} {add:map[] rem:3} {add:map[33:1] rem:24}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 76 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - - X O - - - - -
@@ -1892,7 +2095,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - O - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:67}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 33 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 33 35 37 41 44 45 47 50 52 53 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - - X O - - - - -
@@ -1934,7 +2137,7 @@ func ExampleGame_proper9Game() {
 	// - - - - - O - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:53} {add:map[] rem:3}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 11:1 12:2 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 19 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - - X O - - - - -
@@ -1976,7 +2179,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 3:2 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:53} {add:map[] rem:19} {add:map[] rem:76}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 21 25 26 27 33 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 21 25 26 27 33 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 77 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - - X O - - - - -
@@ -2018,7 +2221,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 3:2 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:53}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 10 13 14 15 16 17 18 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - - X O - - - - -
@@ -2060,7 +2263,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:10} {add:map[] rem:19}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 27 33 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 27 33 35 37 41 44 45 47 50 52 54 55 56 58 59 60 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - X X O - - - - -
@@ -2102,7 +2305,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:10} {add:map[] rem:60} {add:map[] rem:77}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 27 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - X X O - - - - -
@@ -2144,7 +2347,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:10}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - - O - - - - -
 	// - X X O - - - - -
@@ -2186,7 +2389,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:2} {add:map[] rem:60}] currentColor:1 favourableLegalActions:[1 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X - O O - - - - -
 	// - X X O - - - - -
@@ -2228,7 +2431,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:2} {add:map[] rem:1} {add:map[] rem:27}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - - - - -
 	// - X X O - - - - -
@@ -2270,7 +2473,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:2}] currentColor:1 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 58 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
 	// Current position:
 	// X X O O - - - - -
 	// - X X O - - - - -
@@ -2312,7 +2515,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:58} {add:map[] rem:1}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - - - - -
 	// - X X O - - - - -
@@ -2354,7 +2557,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:58} {add:map[] rem:-1} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 5 6 7 8 9 13 14 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
 	// Current position:
 	// X X O O - - - - -
 	// - X X O - - - - -
@@ -2396,7 +2599,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:58}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[4 5 6 7 8 9 13 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - - - - -
 	// - X X O - X - - -
@@ -2438,7 +2641,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:5} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[4 6 7 8 9 13 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 6 7 8 9 13 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O - X - - -
@@ -2480,7 +2683,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:5} {add:map[] rem:-1} {add:map[] rem:14}] currentColor:2 favourableLegalActions:[4 6 7 8 9 13 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 6 7 8 9 13 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O - X - - -
@@ -2522,7 +2725,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:5}] currentColor:1 favourableLegalActions:[6 7 8 9 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:true cleanup:false}] currentColor:1 favourableLegalActions:[6 7 8 9 15 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O O X - - -
@@ -2564,7 +2767,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:-1} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[4 6 7 8 9 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 14:1 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 6 7 8 9 15 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O O X - - -
@@ -2606,7 +2809,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:-1} {add:map[14:1] rem:15} {add:map[] rem:13}] currentColor:1 favourableLegalActions:[6 7 8 9 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[14:1] rem:15 action:15 lastPass:true cleanup:false}] currentColor:1 favourableLegalActions:[6 7 8 9 16 17 18 21 25 26 35 37 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:false}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O O - O - -
@@ -2648,7 +2851,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:-1}] currentColor:2 favourableLegalActions:[4 6 7 8 9 14 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[14:1] rem:15 action:15 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 6 7 8 9 14 16 17 18 21 25 26 33 35 41 44 45 47 50 52 54 55 56 59 61 62 63 64 65 66 69 70 71 72 73 74 75 78 79 80 81] lastPass:true}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O O - O - -
@@ -2690,7 +2893,7 @@ func ExampleGame_proper9Game() {
 	// - - - - X O - - -
 	// - - - - X O - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:-1} {add:map[14:1] rem:15}] currentColor:1 favourableLegalActions:[] lastPass:true}
+	// {board:map[0:1 1:1 2:2 3:2 5:2 10:1 11:1 12:2 13:2 15:2 19:1 20:2 22:2 23:2 24:2 27:1 28:2 29:1 30:2 31:1 32:2 34:2 36:1 38:1 39:1 40:1 42:2 43:2 46:1 48:1 49:1 51:2 53:2 57:1 58:1 60:2 67:1 68:2 76:1 77:2] differences:[{add:map[] rem:40 action:40 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[] rem:38 action:38 lastPass:false cleanup:false} {add:map[] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:29 action:29 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:31 action:31 lastPass:false cleanup:false} {add:map[21:1] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:48 action:48 lastPass:false cleanup:false} {add:map[] rem:42 action:42 lastPass:false cleanup:false} {add:map[30:2 39:2] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:43 action:43 lastPass:false cleanup:false} {add:map[] rem:39 action:39 lastPass:false cleanup:false} {add:map[21:1] rem:30 action:30 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:28 action:28 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:49 action:49 lastPass:false cleanup:false} {add:map[] rem:32 action:32 lastPass:false cleanup:false} {add:map[] rem:33 action:33 lastPass:false cleanup:false} {add:map[] rem:51 action:51 lastPass:false cleanup:false} {add:map[] rem:57 action:57 lastPass:false cleanup:false} {add:map[] rem:68 action:68 lastPass:false cleanup:false} {add:map[] rem:46 action:46 lastPass:false cleanup:false} {add:map[] rem:34 action:34 lastPass:false cleanup:false} {add:map[] rem:36 action:36 lastPass:false cleanup:false} {add:map[33:1] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:67 action:67 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:76 action:76 lastPass:false cleanup:false} {add:map[] rem:53 action:53 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[] rem:77 action:77 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:60 action:60 lastPass:false cleanup:false} {add:map[] rem:27 action:27 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:58 action:58 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:true cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[14:1] rem:15 action:15 lastPass:true cleanup:false} {add:map[] rem:-1 action:81 lastPass:false cleanup:false} {add:map[] rem:-1 action:81 lastPass:true cleanup:false}] currentColor:1 favourableLegalActions:[] lastPass:true}
 	// Current position:
 	// X X O O - O - - -
 	// - X X O O - O - -
@@ -2737,10 +2940,9 @@ func ExampleGame_proper9Game() {
 }
 
 func ExampleGame_foul5Game() {
-	config.Int["boardsize"] = 5
-	replayGame("sgf/foul5Game.sgf")
+	replayGame("sgf/foul5Game.sgf", 5)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -2766,7 +2968,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[6:1] differences:[{add:map[] rem:6} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[6:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - X - - -
@@ -2792,7 +2994,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[6:1 12:2] differences:[{add:map[] rem:6} {add:map[] rem:12} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[6:1 12:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - X - - -
@@ -2818,7 +3020,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:1 6:1 12:2] differences:[{add:map[] rem:6}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 12:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - - -
@@ -2844,7 +3046,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:1 6:1 10:2 12:2] differences:[{add:map[] rem:10} {add:map[] rem:12}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 8 9 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 10:2 12:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 8 9 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - - -
@@ -2870,7 +3072,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 6:1 10:2 12:2] differences:[{add:map[] rem:10} {add:map[] rem:0} {add:map[] rem:2}] currentColor:2 favourableLegalActions:[3 4 5 7 8 9 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 6:1 10:2 12:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 5 7 8 9 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// - X - - -
@@ -2896,7 +3098,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 6:1 10:2 12:2 16:2] differences:[{add:map[] rem:10}] currentColor:1 favourableLegalActions:[1 3 4 5 7 8 9 11 13 14 15 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 6:1 10:2 12:2 16:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 3 4 5 7 8 9 11 13 14 15 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// - X - - -
@@ -2922,7 +3124,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 6:1 7:1 10:2 12:2 16:2] differences:[{add:map[] rem:7} {add:map[] rem:0}] currentColor:2 favourableLegalActions:[3 4 5 8 9 11 13 14 15 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 6:1 7:1 10:2 12:2 16:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 5 8 9 11 13 14 15 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// - X X - -
@@ -2948,7 +3150,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:7} {add:map[] rem:18} {add:map[] rem:16}] currentColor:1 favourableLegalActions:[1 3 4 5 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 3 4 5 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// - X X - -
@@ -2974,7 +3176,7 @@ func ExampleGame_foul5Game() {
 	// - - - - -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:7}] currentColor:2 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// X X X - -
@@ -3000,7 +3202,7 @@ func ExampleGame_foul5Game() {
 	// - O - - -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:-1} {add:map[] rem:18}] currentColor:1 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// X X X - -
@@ -3026,7 +3228,7 @@ func ExampleGame_foul5Game() {
 	// - O - - -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:-1} {add:map[] rem:1} {add:map[] rem:5}] currentColor:2 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X - -
 	// X X X - -
@@ -3052,7 +3254,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 10:2 12:2 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 8 9 11 13 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O -
 	// X X X - -
@@ -3078,7 +3280,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 10:2 12:2 13:1 16:2 18:2] differences:[{add:map[] rem:13} {add:map[] rem:1}] currentColor:2 favourableLegalActions:[4 8 9 11 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 10:2 12:2 13:1 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 8 9 11 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O -
 	// X X X - -
@@ -3104,7 +3306,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 13:1 16:2 18:2] differences:[{add:map[] rem:13} {add:map[] rem:8} {add:map[] rem:3}] currentColor:1 favourableLegalActions:[4 9 14 15 17 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 13:1 16:2 18:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 9 14 15 17 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O -
 	// X X X O -
@@ -3130,7 +3332,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 13:1 16:2 18:2 19:1] differences:[{add:map[] rem:13}] currentColor:2 favourableLegalActions:[4 9 11 14 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 13:1 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 9 11 14 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O -
 	// X X X O -
@@ -3156,7 +3358,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[13:1] rem:14} {add:map[] rem:8}] currentColor:1 favourableLegalActions:[4 9 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 5:1 6:1 7:1 8:2 10:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 9 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O -
 	// X X X O -
@@ -3182,7 +3384,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 1:1 2:1 3:2 4:1 5:1 6:1 7:1 8:2 10:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[13:1] rem:14} {add:map[] rem:4} {add:map[] rem:19}] currentColor:2 favourableLegalActions:[9 11 13 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 1:1 2:1 3:2 4:1 5:1 6:1 7:1 8:2 10:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[9 11 13 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X X X O X
 	// X X X O -
@@ -3208,7 +3410,7 @@ func ExampleGame_foul5Game() {
 	// - O - O -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 4:1 8:2 10:2 11:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[13:1] rem:14}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[3:2 4:1 8:2 10:2 11:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11 action:11 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O X
 	// - - - O -
@@ -3234,7 +3436,7 @@ func ExampleGame_foul5Game() {
 	// - O - O X
 	// - - - - -
 	// [[[0 0 1 0 1 0 1 0 0] [0 0 1 0 1 0 1 0 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0]] [[0 0 1 0 1 0 1 0 0] [0 0 1 0 1 0 1 0 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[3:2 8:2 10:2 11:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[4:1] rem:-1} {add:map[] rem:4}] currentColor:2 favourableLegalActions:[0 1 2 4 5 6 7 9 13 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[3:2 8:2 10:2 11:2 12:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11 action:11 lastPass:false cleanup:false} {add:map[4:1] rem:-1 action:9 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 4 5 6 7 9 13 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O -
 	// - - - O -
@@ -3260,7 +3462,7 @@ func ExampleGame_foul5Game() {
 	// - O - O X
 	// - - - - -
 	// [[[0 0 0 0 0 1 0 1 1] [0 0 0 0 0 1 0 1 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 1 0 1 0 0 1]] [[0 0 0 0 0 1 0 1 1] [0 0 0 0 0 1 0 1 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[4:1] rem:-1} {add:map[] rem:13} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11}] currentColor:1 favourableLegalActions:[0 1 2 4 5 6 7 9 15 17 20 21 22 23 24 25] lastPass:false}
+	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11 action:11 lastPass:false cleanup:false} {add:map[4:1] rem:-1 action:9 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 4 5 6 7 9 15 17 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O -
 	// - - - O -
@@ -3286,7 +3488,7 @@ func ExampleGame_foul5Game() {
 	// - O - O X
 	// - - - - -
 	// [[[0 0 0 0 0 0 1 0 0] [0 0 0 0 0 0 1 0 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 1 0 1 0 0]] [[0 0 0 0 0 0 1 0 0] [0 0 0 0 0 0 1 0 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[4:1] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 4 5 6 7 9 15 17 20 21 22 23 24 25] lastPass:true}
+	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11 action:11 lastPass:false cleanup:false} {add:map[4:1] rem:-1 action:9 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 4 5 6 7 9 15 17 20 21 22 23 24 25] lastPass:true}
 	// Current position:
 	// - - - O -
 	// - - - O -
@@ -3312,7 +3514,7 @@ func ExampleGame_foul5Game() {
 	// - O - O X
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:-1} {add:map[] rem:13}] currentColor:1 favourableLegalActions:[] lastPass:true}
+	// {board:map[3:2 8:2 10:2 11:2 12:2 13:2 14:2 16:2 18:2 19:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:-1 action:1 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[13:1] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[0:1 1:1 2:1 5:1 6:1 7:1] rem:11 action:11 lastPass:false cleanup:false} {add:map[4:1] rem:-1 action:9 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:true cleanup:false}] currentColor:1 favourableLegalActions:[] lastPass:true}
 	// Current position:
 	// - - - O -
 	// - - - O -
@@ -3343,10 +3545,9 @@ func ExampleGame_foul5Game() {
 }
 
 func ExampleGame_properGameWithComplexOutcome() {
-	config.Int["boardsize"] = 5
-	replayGame("sgf/proper5GameWithComplexOutcome.sgf")
+	replayGame("sgf/proper5GameWithComplexOutcome.sgf", 5)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -3372,7 +3573,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[12:1] differences:[{add:map[] rem:12} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[12:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -3398,7 +3599,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[11:2 12:1] differences:[{add:map[] rem:12} {add:map[] rem:11} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[11:2 12:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -3424,7 +3625,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[7:1 11:2 12:1] differences:[{add:map[] rem:12}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[7:1 11:2 12:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - X - -
@@ -3450,7 +3651,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[7:1 11:2 12:1 17:2] differences:[{add:map[] rem:17} {add:map[] rem:11}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[7:1 11:2 12:1 17:2] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - X - -
@@ -3476,7 +3677,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[5:1 7:1 11:2 12:1 17:2] differences:[{add:map[] rem:17} {add:map[] rem:5} {add:map[] rem:7}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[5:1 7:1 11:2 12:1 17:2] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3502,7 +3703,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[5:1 7:1 11:2 12:1 17:2 23:2] differences:[{add:map[] rem:17}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 18 19 20 21 22 24 25] lastPass:false}
+	// {board:map[5:1 7:1 11:2 12:1 17:2 23:2] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 18 19 20 21 22 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3528,7 +3729,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[5:1 7:1 11:2 12:1 17:2 18:1 23:2] differences:[{add:map[] rem:18} {add:map[] rem:5}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 19 20 21 22 24 25] lastPass:false}
+	// {board:map[5:1 7:1 11:2 12:1 17:2 18:1 23:2] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 10 13 14 15 16 19 20 21 22 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3554,7 +3755,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2] differences:[{add:map[] rem:18} {add:map[] rem:10} {add:map[] rem:23}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 24 25] lastPass:false}
+	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3580,7 +3781,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:18}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 25] lastPass:false}
+	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3606,7 +3807,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O - -
 	// - - - O -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1]]]
-	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:-1} {add:map[] rem:10}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 25] lastPass:true}
+	// {board:map[5:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 6 8 9 13 14 15 16 19 20 21 22 25] lastPass:true}
 	// Current position:
 	// - - - - -
 	// X - X - -
@@ -3632,7 +3833,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O X -
 	// - - - O -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0]]]
-	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:-1} {add:map[] rem:6} {add:map[] rem:24}] currentColor:2 favourableLegalActions:[0 1 2 3 4 8 9 13 14 15 16 19 20 21 22 25] lastPass:false}
+	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 8 9 13 14 15 16 19 20 21 22 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// X X X - -
@@ -3658,7 +3859,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O X -
 	// - - - O -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1]]]
-	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 8 9 13 14 15 16 19 20 21 22 25] lastPass:true}
+	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:true cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 8 9 13 14 15 16 19 20 21 22 25] lastPass:true}
 	// Current position:
 	// - - - - -
 	// X X X - -
@@ -3684,7 +3885,7 @@ func ExampleGame_properGameWithComplexOutcome() {
 	// - - O X -
 	// - - - O X
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]]]
-	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:-1} {add:map[] rem:6}] currentColor:2 favourableLegalActions:[] lastPass:true}
+	// {board:map[5:1 6:1 7:1 10:2 11:2 12:1 17:2 18:1 23:2 24:1] differences:[{add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:true cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[] lastPass:true}
 	// Current position:
 	// - - - - -
 	// X X X - -
@@ -3715,10 +3916,9 @@ func ExampleGame_properGameWithComplexOutcome() {
 }
 
 func ExampleGame_proper5GameWithSimpleOutcome() {
-	config.Int["boardsize"] = 5
-	replayGame("sgf/proper5GameWithSimpleOutcome.sgf")
+	replayGame("sgf/proper5GameWithSimpleOutcome.sgf", 5)
     // Output:
-    // {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - - - -
@@ -3744,7 +3944,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-    // {board:map[7:1] differences:[{add:map[] rem:7} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[7:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - X - -
@@ -3770,7 +3970,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-    // {board:map[7:1 13:2] differences:[{add:map[] rem:7} {add:map[] rem:13} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[7:1 13:2] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - X - -
@@ -3796,7 +3996,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-    // {board:map[7:1 13:2 15:1] differences:[{add:map[] rem:7}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[7:1 13:2 15:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 11 12 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - X - -
@@ -3822,7 +4022,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-    // {board:map[7:1 11:2 13:2 15:1] differences:[{add:map[] rem:11} {add:map[] rem:13}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 12 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[7:1 11:2 13:2 15:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 12 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - X - -
@@ -3848,7 +4048,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-    // {board:map[7:1 11:2 12:1 13:2 15:1] differences:[{add:map[] rem:11} {add:map[] rem:12} {add:map[] rem:15}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[7:1 11:2 12:1 13:2 15:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 8 9 10 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - - X - -
@@ -3874,7 +4074,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // - - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-    // {board:map[6:2 7:1 11:2 12:1 13:2 15:1] differences:[{add:map[] rem:11}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 8 9 10 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+    // {board:map[6:2 7:1 11:2 12:1 13:2 15:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 8 9 10 14 16 17 18 19 20 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - O X - -
@@ -3900,7 +4100,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-    // {board:map[6:2 7:1 11:2 12:1 13:2 15:1 20:1] differences:[{add:map[] rem:20} {add:map[] rem:12}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 8 9 10 14 16 17 18 19 21 22 23 24 25] lastPass:false}
+    // {board:map[6:2 7:1 11:2 12:1 13:2 15:1 20:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 8 9 10 14 16 17 18 19 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - - - -
     // - O X - -
@@ -3926,7 +4126,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 20:1] differences:[{add:map[] rem:20} {add:map[] rem:2} {add:map[] rem:6}] currentColor:1 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 17 18 19 21 22 23 24 25] lastPass:false}
+    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 20:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 17 18 19 21 22 23 24 25] lastPass:false}
     // Current position:
     // - - O - -
     // - O X - -
@@ -3952,7 +4152,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 20:1 24:1] differences:[{add:map[] rem:20}] currentColor:2 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 17 18 19 21 22 23 25] lastPass:false}
+    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 17 18 19 21 22 23 25] lastPass:false}
     // Current position:
     // - - O - -
     // - O X - -
@@ -3978,7 +4178,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // - - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1]]]
-    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 17:2 20:1 24:1] differences:[{add:map[] rem:17} {add:map[] rem:2}] currentColor:1 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 18 19 21 22 23 25] lastPass:false}
+    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 17:2 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 18 19 21 22 23 25] lastPass:false}
     // Current position:
     // - - O - -
     // - O X - -
@@ -4004,7 +4204,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // X - - - -
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0]]]
-    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:17} {add:map[] rem:19} {add:map[] rem:24}] currentColor:2 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[2:2 6:2 7:1 11:2 12:1 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 8 9 10 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // - - O - -
     // - O X - -
@@ -4030,7 +4230,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // X - - - -
     // [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1]]]
-    // {board:map[2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:17}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // - - O - -
     // - O - O -
@@ -4056,7 +4256,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - - - -
     // X - - - X
     // [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:0} {add:map[] rem:19}] currentColor:2 favourableLegalActions:[1 3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X - O - -
     // - O - O -
@@ -4082,7 +4282,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - -
     // X - - - X
     // [[[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:0} {add:map[] rem:1} {add:map[7:1 12:1] rem:8}] currentColor:1 favourableLegalActions:[3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 6:2 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[3 4 5 7 9 10 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - -
     // - O - O -
@@ -4108,7 +4308,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[1 0 1 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:0}] currentColor:2 favourableLegalActions:[3 4 5 9 10 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 5 9 10 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - -
     // - O X O -
@@ -4134,7 +4334,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[0 1 0 1 0 1 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 4:2 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:4} {add:map[] rem:1}] currentColor:1 favourableLegalActions:[5 9 10 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[5 9 10 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // - O X O -
@@ -4160,7 +4360,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:4} {add:map[] rem:5} {add:map[] rem:7}] currentColor:2 favourableLegalActions:[3 9 10 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 9 10 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O -
@@ -4186,7 +4386,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:4}] currentColor:1 favourableLegalActions:[10 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[10 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4212,7 +4412,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:10} {add:map[] rem:5}] currentColor:2 favourableLegalActions:[3 12 14 16 18 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 12 14 16 18 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4238,7 +4438,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 18:2 19:1 20:1 24:1] differences:[{add:map[] rem:10} {add:map[] rem:18} {add:map[] rem:9}] currentColor:1 favourableLegalActions:[14 16 21 22 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 18:2 19:1 20:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[14 16 21 22 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4264,7 +4464,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 18:2 19:1 20:1 22:1 24:1] differences:[{add:map[] rem:10}] currentColor:2 favourableLegalActions:[3 12 14 16 21 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 15:1 17:2 18:2 19:1 20:1 22:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 12 14 16 21 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4290,7 +4490,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 24:1] differences:[{add:map[] rem:14} {add:map[] rem:18}] currentColor:1 favourableLegalActions:[16 21 23 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[16 21 23 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4316,7 +4516,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O - X
     // X - - - X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:14} {add:map[] rem:23} {add:map[] rem:22}] currentColor:2 favourableLegalActions:[3 12 16 21 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 12 16 21 25] lastPass:false}
     // Current position:
     // X O O - O
     // X O X O O
@@ -4342,7 +4542,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O O X
     // X - - - X
     // [[[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:14}] currentColor:1 favourableLegalActions:[16 21 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[16 21 25] lastPass:false}
     // Current position:
     // X O O O O
     // X O X O O
@@ -4368,7 +4568,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O O X
     // X - X - X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 16:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:16} {add:map[] rem:23}] currentColor:2 favourableLegalActions:[12 21 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 7:1 8:2 9:2 10:1 11:2 13:2 14:2 15:1 16:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[12 21 25] lastPass:false}
     // Current position:
     // X O O O O
     // X O X O O
@@ -4394,7 +4594,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O O X
     // X - X - X
     // [[[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 8:2 9:2 10:1 11:2 12:2 13:2 14:2 15:1 16:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:16} {add:map[7:1] rem:12} {add:map[] rem:3}] currentColor:1 favourableLegalActions:[7 25] lastPass:false}
+    // {board:map[0:1 1:2 2:2 3:2 4:2 5:1 6:2 8:2 9:2 10:1 11:2 12:2 13:2 14:2 15:1 16:1 17:2 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[7 25] lastPass:false}
     // Current position:
     // X O O O O
     // X O - O O
@@ -4420,7 +4620,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O O X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 5:1 7:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:16}] currentColor:2 favourableLegalActions:[1 2 3 4 6 8 9 11 12 13 14 17 18 25] lastPass:false}
+    // {board:map[0:1 5:1 7:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 3 4 6 8 9 11 12 13 14 17 18 25] lastPass:false}
     // Current position:
     // X - - - -
     // X - X - -
@@ -4446,7 +4646,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X - O O X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 1 0 0 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 0 0 0 0 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 3:2 5:1 7:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3} {add:map[7:1] rem:12}] currentColor:1 favourableLegalActions:[1 2 4 6 8 9 11 12 13 14 17 18 21 25] lastPass:false}
+    // {board:map[0:1 3:2 5:1 7:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 2 4 6 8 9 11 12 13 14 17 18 21 25] lastPass:false}
     // Current position:
     // X - - O -
     // X - X - -
@@ -4472,7 +4672,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X O O X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0] [0 1 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 0 0 1 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3} {add:map[] rem:8} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7}] currentColor:2 favourableLegalActions:[1 2 4 6 9 11 12 13 14 17 18 25] lastPass:false}
+    // {board:map[0:1 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[1 2 4 6 9 11 12 13 14 17 18 25] lastPass:false}
     // Current position:
     // X - - O -
     // X - X X -
@@ -4498,7 +4698,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X O O X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1] [1 0 1 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 0 1] [0 1 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 2:2 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3}] currentColor:1 favourableLegalActions:[1 4 6 9 11 12 13 14 17 18 21 25] lastPass:false}
+    // {board:map[0:1 2:2 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 4 6 9 11 12 13 14 17 18 21 25] lastPass:false}
     // Current position:
     // X - O O -
     // X - X X -
@@ -4524,7 +4724,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 2:2 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:1} {add:map[] rem:8}] currentColor:2 favourableLegalActions:[4 6 9 11 12 13 14 17 18 25] lastPass:false}
+    // {board:map[0:1 1:1 2:2 3:2 5:1 7:1 8:1 10:1 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[4 6 9 11 12 13 14 17 18 25] lastPass:false}
     // Current position:
     // X X O O -
     // X - X X -
@@ -4550,7 +4750,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:1 2:2 3:2 5:1 7:1 8:1 10:1 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:1} {add:map[] rem:13} {add:map[] rem:2}] currentColor:1 favourableLegalActions:[4 6 9 11 12 14 17 18 21 25] lastPass:false}
+    // {board:map[0:1 1:1 2:2 3:2 5:1 7:1 8:1 10:1 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[4 6 9 11 12 14 17 18 21 25] lastPass:false}
     // Current position:
     // X X O O -
     // X - X X -
@@ -4576,7 +4776,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:1}] currentColor:2 favourableLegalActions:[2 3 6 9 11 12 14 17 18 25] lastPass:false}
+    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[2 3 6 9 11 12 14 17 18 25] lastPass:false}
     // Current position:
     // X X - - X
     // X - X X -
@@ -4602,7 +4802,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 1 0 1 0 1 0 1] [0 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 12:2 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:12} {add:map[] rem:13}] currentColor:1 favourableLegalActions:[2 3 6 9 11 14 17 18 21 25] lastPass:false}
+    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 12:2 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 3 6 9 11 14 17 18 21 25] lastPass:false}
     // Current position:
     // X X - - X
     // X - X X -
@@ -4628,7 +4828,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:12} {add:map[] rem:11} {add:map[2:2 3:2] rem:4}] currentColor:2 favourableLegalActions:[2 3 9 14 17 18 25] lastPass:false}
+    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[2 3 9 14 17 18 25] lastPass:false}
     // Current position:
     // X X - - X
     // X - X X -
@@ -4654,7 +4854,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:12}] currentColor:1 favourableLegalActions:[2 3 6 9 17 18 21 25] lastPass:false}
+    // {board:map[0:1 1:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 3 6 9 17 18 21 25] lastPass:false}
     // Current position:
     // X X - - X
     // X - X X -
@@ -4680,7 +4880,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3} {add:map[] rem:11}] currentColor:2 favourableLegalActions:[9 17 18 25] lastPass:false}
+    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[9 17 18 25] lastPass:false}
     // Current position:
     // X X - X X
     // X - X X -
@@ -4706,7 +4906,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3} {add:map[] rem:18} {add:map[] rem:14}] currentColor:1 favourableLegalActions:[2 6 9 17 21 25] lastPass:false}
+    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 6 9 17 21 25] lastPass:false}
     // Current position:
     // X X - X X
     // X - X X -
@@ -4732,7 +4932,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:3}] currentColor:2 favourableLegalActions:[25] lastPass:false}
+    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[25] lastPass:false}
     // Current position:
     // X X - X X
     // X - X X -
@@ -4758,7 +4958,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1]]]
-    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:-1} {add:map[] rem:18}] currentColor:1 favourableLegalActions:[2 6 9 25] lastPass:true}
+    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[2 6 9 25] lastPass:true}
     // Current position:
     // X X - X X
     // X - X X -
@@ -4784,7 +4984,7 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
     // X X - - X
     // X - X X X
     // [[[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0]]]
-    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:-1} {add:map[] rem:-1} {add:map[] rem:17}] currentColor:2 favourableLegalActions:[] lastPass:true}
+    // {board:map[0:1 1:1 3:1 4:1 5:1 7:1 8:1 10:1 11:1 12:2 13:2 14:2 15:1 16:1 17:1 18:2 19:1 20:1 22:1 23:1 24:1] differences:[{add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[7:1 12:1] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:16 action:16 lastPass:false cleanup:false} {add:map[7:1] rem:12 action:12 lastPass:false cleanup:false} {add:map[1:2 2:2 3:2 4:2 6:2 8:2 9:2 11:2 12:2 13:2 14:2 17:2 18:2] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[2:2 3:2] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[] lastPass:true}
     // Current position:
     // X X - X X
     // X - X X -
@@ -4815,10 +5015,9 @@ func ExampleGame_proper5GameWithSimpleOutcome() {
 }
 
 func ExampleGame_forbiddenSuicideEye() {
-	config.Int["boardsize"] = 5
-	replayGame("sgf/forbiddenSuicideEye.sgf")
+	replayGame("sgf/forbiddenSuicideEye.sgf", 5)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -4844,7 +5043,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[21:1] differences:[{add:map[] rem:21} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 22 23 24 25] lastPass:false}
+	// {board:map[21:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -4870,7 +5069,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[4:2 21:1] differences:[{add:map[] rem:21} {add:map[] rem:4} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 22 23 24 25] lastPass:false}
+	// {board:map[4:2 21:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - O
 	// - - - - -
@@ -4896,7 +5095,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[4:2 15:1 21:1] differences:[{add:map[] rem:21}] currentColor:2 favourableLegalActions:[0 1 2 3 5 6 7 8 9 10 11 12 13 14 16 17 18 19 22 23 24 25] lastPass:false}
+	// {board:map[4:2 15:1 21:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 5 6 7 8 9 10 11 12 13 14 16 17 18 19 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - O
 	// - - - - -
@@ -4922,7 +5121,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 4:2 15:1 21:1] differences:[{add:map[] rem:3} {add:map[] rem:4}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 9 10 11 12 13 14 16 17 18 19 20 22 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 15:1 21:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 9 10 11 12 13 14 16 17 18 19 20 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - -
@@ -4948,7 +5147,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - X - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[3:2 4:2 15:1 21:1 22:1] differences:[{add:map[] rem:3} {add:map[] rem:22} {add:map[] rem:15}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 9 10 11 12 13 14 16 17 18 19 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 15:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 9 10 11 12 13 14 16 17 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - -
@@ -4974,7 +5173,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// - - - - -
 	// - X - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 4:2 9:2 15:1 21:1 22:1] differences:[{add:map[] rem:3}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 14 16 17 18 19 20 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 9:2 15:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 14 16 17 18 19 20 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - O
@@ -5000,7 +5199,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - - - -
 	// - X - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[3:2 4:2 9:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:17} {add:map[] rem:22}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 14 16 18 19 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 9:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 14 16 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - O
@@ -5026,7 +5225,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - - - -
 	// - X - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[3:2 4:2 9:2 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:17} {add:map[] rem:14} {add:map[] rem:9}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 16 18 19 20 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 9:2 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 5 6 7 8 10 11 12 13 16 18 19 20 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - O
@@ -5052,7 +5251,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - - - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[3:2 4:2 9:2 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:17}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 10 11 13 16 18 19 23 24 25] lastPass:false}
+	// {board:map[3:2 4:2 9:2 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 5 6 7 8 10 11 13 16 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - - - O O
 	// - - - - O
@@ -5078,7 +5277,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - - - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:2 3:2 4:2 9:2 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:2} {add:map[] rem:14}] currentColor:1 favourableLegalActions:[0 1 5 6 7 8 10 11 13 16 18 19 20 23 24 25] lastPass:false}
+	// {board:map[2:2 3:2 4:2 9:2 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 5 6 7 8 10 11 13 16 18 19 20 23 24 25] lastPass:false}
 	// Current position:
 	// - - O O O
 	// - - - - O
@@ -5104,7 +5303,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:2 3:2 4:2 9:2 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:2} {add:map[] rem:11} {add:map[] rem:12}] currentColor:2 favourableLegalActions:[0 1 5 6 7 8 10 13 18 19 23 24 25] lastPass:false}
+	// {board:map[2:2 3:2 4:2 9:2 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 5 6 7 8 10 13 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - - O O O
 	// - - - - O
@@ -5130,7 +5329,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 9:2 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:2}] currentColor:1 favourableLegalActions:[0 5 6 7 8 10 13 16 18 19 20 23 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 9:2 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 5 6 7 8 10 13 16 18 19 20 23 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// - - - - O
@@ -5156,7 +5355,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:10} {add:map[] rem:11}] currentColor:2 favourableLegalActions:[0 5 6 7 8 13 18 19 23 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 5 6 7 8 13 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// - - - - O
@@ -5182,7 +5381,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:10} {add:map[] rem:6} {add:map[] rem:1}] currentColor:1 favourableLegalActions:[0 5 7 8 13 18 19 23 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 5 7 8 13 18 19 23 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// - O - - O
@@ -5208,7 +5407,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:10}] currentColor:2 favourableLegalActions:[0 5 7 8 13 18 19 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 5 7 8 13 18 19 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// - O - - O
@@ -5234,7 +5433,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:5} {add:map[] rem:6}] currentColor:1 favourableLegalActions:[7 8 13 18 19 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[7 8 13 18 19 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O - - O
@@ -5260,7 +5459,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:5} {add:map[] rem:8} {add:map[] rem:23}] currentColor:2 favourableLegalActions:[7 13 18 19 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[7 13 18 19 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O - X O
@@ -5286,7 +5485,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X - -
 	// [[[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:5}] currentColor:1 favourableLegalActions:[13 18 19 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[13 18 19 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O O X O
@@ -5312,7 +5511,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X X -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:19} {add:map[] rem:8}] currentColor:2 favourableLegalActions:[13 18 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 8:1 9:2 10:1 11:1 12:1 14:2 15:1 17:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[13 18 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O O X O
@@ -5338,7 +5537,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X X -
 	// [[[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:19} {add:map[8:1] rem:13} {add:map[] rem:7}] currentColor:1 favourableLegalActions:[18 24 25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[8:1] rem:13 action:13 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[18 24 25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O O - O
@@ -5364,7 +5563,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X X -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 0 0] [0 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:19}] currentColor:2 favourableLegalActions:[25] lastPass:false}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[8:1] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[25] lastPass:false}
 	// Current position:
 	// - O O O O
 	// O O O - O
@@ -5390,7 +5589,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - -
 	// - X X X -
 	// [[[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1]] [[1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 1 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:-1} {add:map[8:1] rem:13}] currentColor:1 favourableLegalActions:[25] lastPass:true}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[8:1] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[25] lastPass:true}
 	// Current position:
 	// - O O O O
 	// O O O - O
@@ -5416,7 +5615,7 @@ func ExampleGame_forbiddenSuicideEye() {
 	// X - X - X
 	// - X X X -
 	// [[[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0]] [[0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [0 1 0 1 0 1 0 1 0]] [[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:-1} {add:map[] rem:-1} {add:map[] rem:18}] currentColor:2 favourableLegalActions:[] lastPass:true}
+	// {board:map[1:2 2:2 3:2 4:2 5:2 6:2 7:2 9:2 10:1 11:1 12:1 13:2 14:2 15:1 17:1 18:1 19:1 21:1 22:1 23:1] differences:[{add:map[] rem:21 action:21 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[] rem:15 action:15 lastPass:false cleanup:false} {add:map[] rem:3 action:3 lastPass:false cleanup:false} {add:map[] rem:22 action:22 lastPass:false cleanup:false} {add:map[] rem:9 action:9 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:14 action:14 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:1 action:1 lastPass:false cleanup:false} {add:map[] rem:10 action:10 lastPass:false cleanup:false} {add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:23 action:23 lastPass:false cleanup:false} {add:map[] rem:5 action:5 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:19 action:19 lastPass:false cleanup:false} {add:map[8:1] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:18 action:18 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:false cleanup:false} {add:map[] rem:-1 action:25 lastPass:true cleanup:false}] currentColor:2 favourableLegalActions:[] lastPass:true}
 	// Current position:
 	// - O O O O
 	// O O O - O
@@ -5447,10 +5646,9 @@ func ExampleGame_forbiddenSuicideEye() {
 }
 
 func ExampleGame_allowFakeSuicide() {
-	config.Int["boardsize"] = 5
-	replayGame("sgf/allowFakeSuicide.sgf")
+	replayGame("sgf/allowFakeSuicide.sgf", 5)
 	// Output:
-	// {board:map[] differences:[{add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[] differences:[] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - - - - -
@@ -5476,7 +5674,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[6:1] differences:[{add:map[] rem:6} {add:map[] rem:-1}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[6:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 11 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - X - - -
@@ -5502,7 +5700,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[6:1 11:2] differences:[{add:map[] rem:6} {add:map[] rem:11} {add:map[] rem:-1}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[6:1 11:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 2 3 4 5 7 8 9 10 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - - - -
 	// - X - - -
@@ -5528,7 +5726,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:1 6:1 11:2] differences:[{add:map[] rem:6}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 8 9 10 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 11:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 8 9 10 12 13 14 15 16 17 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - - -
@@ -5554,7 +5752,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:1 6:1 11:2 17:2] differences:[{add:map[] rem:17} {add:map[] rem:11}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 8 9 10 12 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 11:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 8 9 10 12 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - - -
@@ -5580,7 +5778,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:1 6:1 8:1 11:2 17:2] differences:[{add:map[] rem:17} {add:map[] rem:8} {add:map[] rem:2}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 9 10 12 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 8:1 11:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 9 10 12 13 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - X -
@@ -5606,7 +5804,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:1 6:1 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:17}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 7 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - X -
@@ -5632,7 +5830,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - - - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[2:1 6:1 8:1 11:2 12:1 13:2 17:2] differences:[{add:map[] rem:12} {add:map[] rem:8}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 9 10 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 8:1 11:2 12:1 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[0 1 3 4 5 7 9 10 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X - X -
@@ -5658,7 +5856,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[2:1 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:12} {add:map[12:1] rem:7} {add:map[] rem:13}] currentColor:1 favourableLegalActions:[0 1 3 4 5 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[2:1 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[0 1 3 4 5 9 10 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// - - X - -
 	// - X O X -
@@ -5684,7 +5882,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:12}] currentColor:2 favourableLegalActions:[3 4 5 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 4 5 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - -
 	// - X O X -
@@ -5710,7 +5908,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[0 1 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [1 0 1 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 4:2 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:4} {add:map[12:1] rem:7}] currentColor:1 favourableLegalActions:[1 3 5 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 4:2 6:1 7:2 8:1 11:2 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 3 5 9 10 12 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - O
 	// - X O X -
@@ -5736,7 +5934,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[1 0 1 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 1 0 1 0 1 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 1 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2] differences:[{add:map[] rem:4} {add:map[7:2] rem:12} {add:map[] rem:0}] currentColor:2 favourableLegalActions:[3 5 7 9 10 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[7:2] rem:12 action:12 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 5 9 10 14 15 16 18 19 20 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - O
 	// - X - X -
@@ -5762,7 +5960,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2 20:2] differences:[{add:map[] rem:4}] currentColor:1 favourableLegalActions:[1 3 5 7 9 10 14 15 16 18 19 21 22 23 24 25] lastPass:false}
+	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2 20:2] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[7:2] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 3 5 7 9 10 14 15 16 18 19 21 22 23 24 25] lastPass:false}
 	// Current position:
 	// X - X - O
 	// - X - X -
@@ -5788,7 +5986,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 0 0]] [[0 0 0 0 0 0 0 0 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 1 0 1 0] [1 0 1 0 1 0 1 0 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [1 0 1 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0]] [[0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 1 0 1 0 1 0 1 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]] [[0 1 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0] [0 0 0 0 0 0 0 0 0]]]
-	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2 20:2 24:1] differences:[{add:map[] rem:24} {add:map[7:2] rem:12}] currentColor:2 favourableLegalActions:[3 5 7 9 10 14 15 16 18 19 21 22 23 25] lastPass:false}
+	// {board:map[0:1 2:1 4:2 6:1 8:1 11:2 12:1 13:2 17:2 20:2 24:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[7:2] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false}] currentColor:2 favourableLegalActions:[3 5 7 9 10 14 15 16 18 19 21 22 23 25] lastPass:false}
 	// Current position:
 	// X - X - O
 	// - X - X -
@@ -5814,7 +6012,7 @@ func ExampleGame_allowFakeSuicide() {
 	// - - O - -
 	// - - - - -
 	// [[[0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1]] [[0 0 0 0 0 0 0 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 1 0 1] [0 1 0 1 0 1 0 1 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 1 0 1 0 1 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1]] [[0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [1 0 1 0 1 0 1 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1]] [[1 0 1 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 0 0 0 0 0 0 0 1] [0 1 0 0 0 0 0 0 1]]]
-	// {board:map[0:1 2:1 4:2 6:1 7:2 8:1 11:2 13:2 17:2 20:2 24:1] differences:[{add:map[] rem:24} {add:map[12:1] rem:7} {add:map[] rem:20}] currentColor:1 favourableLegalActions:[1 3 5 9 10 12 14 15 16 18 19 21 22 23 25] lastPass:false}
+	// {board:map[0:1 2:1 4:2 6:1 7:2 8:1 11:2 13:2 17:2 20:2 24:1] differences:[{add:map[] rem:6 action:6 lastPass:false cleanup:false} {add:map[] rem:11 action:11 lastPass:false cleanup:false} {add:map[] rem:2 action:2 lastPass:false cleanup:false} {add:map[] rem:17 action:17 lastPass:false cleanup:false} {add:map[] rem:8 action:8 lastPass:false cleanup:false} {add:map[] rem:13 action:13 lastPass:false cleanup:false} {add:map[] rem:12 action:12 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false} {add:map[] rem:0 action:0 lastPass:false cleanup:false} {add:map[] rem:4 action:4 lastPass:false cleanup:false} {add:map[7:2] rem:12 action:12 lastPass:false cleanup:false} {add:map[] rem:20 action:20 lastPass:false cleanup:false} {add:map[] rem:24 action:24 lastPass:false cleanup:false} {add:map[12:1] rem:7 action:7 lastPass:false cleanup:false}] currentColor:1 favourableLegalActions:[1 3 5 9 10 14 15 16 18 19 21 22 23 25] lastPass:false}
 	// Current position:
 	// X - X - O
 	// - X O X -
@@ -0,0 +1,141 @@
+package gogame
+
+/**
+	a ladder is a sequence of ataris: the attacker keeps the defending chain at one liberty,
+	the defender keeps extending or capturing, and the chain is captured once it runs into the edge or into attacking stones
+	the reader only looks at the defending chain and its neighbours, and it ignores the ko rule
+	it gives up after ladderBudget positions and then assumes that the defender escapes
+*/
+
+const ladderBudget = 2000
+
+type ladderReader struct {
+	budget	int // the number of positions the reader may still look at
+}
+
+/**
+	Ladder reads whether the chain of the stone at pos can be captured in a ladder, and lists the attacking moves that work
+	a chain in atari is captured if it cannot escape even with its owner to move, and the working move is its liberty
+	a chain with two liberties is captured if the opponent, moving first, can atari it on one of them and win the ladder
+	chains with more liberties, and empty positions, are never captured
+*/
+func (game *Game) Ladder(pos int) (captured bool, moves []int) {
+	if game.board[pos] == EMPTY {
+		return false, nil
+	}
+	head := game.chainHead[pos]
+	reader := &ladderReader{budget: ladderBudget}
+	liberties := game.chainLibertyList(head)
+	switch len(liberties) {
+	case 1:
+		if !reader.escapes(game, head) {
+			return true, liberties
+		}
+	case 2:
+		for _, liberty := range liberties {
+			if reader.attackWorks(game, head, liberty) {
+				moves = append(moves, liberty)
+			}
+		}
+		return len(moves) > 0, moves
+	}
+	return false, nil
+}
+
+// escapes says whether the chain with the given representative position, which is in atari, escapes with its owner to move
+func (reader *ladderReader) escapes(game *Game, head int) bool {
+	reader.budget--
+	if reader.budget < 0 {
+		return true
+	}
+
+	// the defender extends on its liberty or captures an attacking chain in atari next to it
+	defences := game.chainLibertyList(head)
+	stone := head
+	for {
+		for _, neigh := range game.adjacent[stone] {
+			if game.board[neigh] == other(game.board[head]) && game.chainLiberties[game.chainHead[neigh]] == 1 {
+				defences = append(defences, game.chainLibertyList(game.chainHead[neigh])...)
+			}
+		}
+		stone = game.chainNext[stone]
+		if stone == head {
+			break
+		}
+	}
+
+	for _, defence := range defences {
+		if reader.defenceWorks(game, head, defence) {
+			return true
+		}
+	}
+	return false
+}
+
+// defenceWorks says whether the defending chain escapes after its owner plays the given move
+func (reader *ladderReader) defenceWorks(game *Game, head int, move int) bool {
+	color := game.board[head]
+	if liberties, captures := game.libertiesAfter(move, color); liberties == 0 && !captures {
+		return false
+	}
+	scratch := game.ladderCopy()
+	scratch.placeStone(move, color, nil, nil)
+	head = scratch.chainHead[head]
+	switch liberties := scratch.chainLiberties[head]; {
+	case liberties >= 3:
+		return true
+	case liberties == 2:
+		for _, liberty := range scratch.chainLibertyList(head) {
+			if reader.attackWorks(scratch, head, liberty) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// attackWorks says whether the defending chain, which has two liberties, is captured after the attacker ataris it with the given move
+func (reader *ladderReader) attackWorks(game *Game, head int, move int) bool {
+	attacker := other(game.board[head])
+	if liberties, captures := game.libertiesAfter(move, attacker); liberties == 0 && !captures {
+		return false
+	}
+	scratch := game.ladderCopy()
+	scratch.placeStone(move, attacker, nil, nil)
+	return !reader.escapes(scratch, scratch.chainHead[head])
+}
+
+// ladderCopy copies only the board and its chains, which is all the ladder reader plays on
+func (game *Game) ladderCopy() *Game {
+	scratch := &Game{
+		board: make([]int, len(game.board)),
+		chainHead: make([]int, len(game.board)),
+		chainNext: make([]int, len(game.board)),
+		chainSize: make([]int, len(game.board)),
+		chainLiberties: make([]int, len(game.board)),
+		adjacent: game.adjacent,
+		options: game.options}
+	copy(scratch.board, game.board)
+	copy(scratch.chainHead, game.chainHead)
+	copy(scratch.chainNext, game.chainNext)
+	copy(scratch.chainSize, game.chainSize)
+	copy(scratch.chainLiberties, game.chainLiberties)
+	return scratch
+}
+
+// ladderEscape says whether the player to move would extend an own chain in atari that is captured in a ladder anyway
+func (game *Game) ladderEscape(action int) bool {
+	if _, captures := game.libertiesAfter(action, game.currentColor); captures {
+		return false
+	}
+	for _, neigh := range game.adjacent[action] {
+		if game.board[neigh] != game.currentColor || game.chainLiberties[game.chainHead[neigh]] != 1 {
+			continue
+		}
+		if captured, _ := game.Ladder(neigh); captured {
+			return true
+		}
+	}
+	return false
+}
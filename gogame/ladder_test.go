@@ -0,0 +1,99 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"fmt"
+	"testing"
+)
+
+// the white stone runs up and to the right in a ladder once Black ataris it from the right
+var ladderRows = []string{
+	"---------",
+	"---------",
+	"---------",
+	"---------",
+	"---------",
+	"-X-------",
+	"-XO------",
+	"--X------",
+	"---------"}
+
+// withBreaker puts a white stone on the path of the ladder
+func withBreaker(rows []string) []string {
+	broken := append([]string{}, rows...)
+	broken[1] = "------O--"
+	return broken
+}
+
+// withAtari lets Black atari the white stone from the right
+func withAtari(rows []string) []string {
+	atari := append([]string{}, rows...)
+	atari[6] = "-XOX-----"
+	return atari
+}
+
+func TestLadder(t *testing.T) {
+	for _, test := range []struct {
+		rows		[]string
+		pos		[2]int
+		captured	bool
+		moves		[][2]int
+	}{
+		{ladderRows, [2]int{6, 2}, true, [][2]int{{6, 3}}},
+		{withBreaker(ladderRows), [2]int{6, 2}, false, nil},
+		{withAtari(ladderRows), [2]int{6, 2}, true, [][2]int{{5, 2}}},
+		{withAtari(withBreaker(ladderRows)), [2]int{6, 2}, false, nil},
+		{ladderRows, [2]int{7, 2}, false, nil}, // three liberties
+		{ladderRows, [2]int{0, 0}, false, nil}, // empty
+	} {
+		options, setup := rowsSetup(test.rows)
+		game := NewFromSetup(options, setup)
+		var expectedMoves []int
+		for _, move := range test.moves {
+			expectedMoves = append(expectedMoves, options.Point(move[0], move[1]))
+		}
+		captured, moves := game.Ladder(options.Point(test.pos[0], test.pos[1]))
+		if captured != test.captured || fmt.Sprint(moves) != fmt.Sprint(expectedMoves) {
+			t.Errorf("Ladder at %v is %t with moves %v instead of %t with moves %v\n%v",
+				test.pos, captured, moves, test.captured, expectedMoves, game)
+		}
+	}
+}
+
+func TestLadderEscapeFilter(t *testing.T) {
+	for _, rows := range [][]string{withAtari(ladderRows), withAtari(withBreaker(ladderRows))} {
+		options, setup := rowsSetup(rows)
+		options.ActionFilters = ActionFiltersFromString("own_eye,ladder_escape")
+		setup.ToMove = config.WHITE
+		game := NewFromSetup(options, setup)
+		escape := options.Point(5, 2)
+		captured, _ := game.Ladder(options.Point(6, 2))
+		if !contains(game.LegalActions(), escape) || contains(game.FavourableLegalActions(), escape) != !captured {
+			t.Errorf("Running from a ladder that captures %t has favourable legal actions %v",
+				captured, game.FavourableLegalActions())
+		}
+	}
+}
+
+func TestLadderFeature(t *testing.T) {
+	options, setup := rowsSetup(ladderRows)
+	options.FeatureSet = "extended_ladders"
+	game := NewFromSetup(options, setup)
+	observation := game.Observation()
+
+	channel := 0
+	for _, name := range FEATURE_SETS[options.FeatureSet] {
+		if name == "ladder" {
+			break
+		}
+		channel += FEATURES[name].numPlanes(options)
+	}
+	for pos := range game.board {
+		row, column := options.Coordinates(pos)
+		laddered := observation[row][column][channel] != 0.0
+		ladderMove := observation[row][column][channel+1] != 0.0
+		if laddered != (pos == options.Point(6, 2)) || ladderMove != (pos == options.Point(6, 3)) {
+			t.Errorf("Ladder planes at row %d and column %d are %t and %t", row, column, laddered, ladderMove)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package gogame
+
+import (
+	"fmt"
+)
+
+// Legality says whether the current player may take an action and, if not, why
+type Legality int
+
+const (
+	LEGAL Legality = iota
+	OFF_BOARD    // the action is neither a board action nor the pass action
+	GAME_OVER    // both players have passed, or the game reached the maximum number of moves
+	OCCUPIED     // there is already a stone
+	SUICIDE      // the new stone's chain would have no liberties and capture nothing
+	SUPERKO      // the position after the action has been reached before
+	OWN_EYE      // the action fills one of the player's own eyes; the rules allow it, but the own_eye filter drops it from the favourable actions
+	EARLY_PASS   // the options forbid passing this early in the game while some board action is favourable
+)
+
+func (legality Legality) String() string {
+	switch legality {
+	case LEGAL:
+		return "legal"
+	case OFF_BOARD:
+		return "off the board"
+	case GAME_OVER:
+		return "game over"
+	case OCCUPIED:
+		return "occupied"
+	case SUICIDE:
+		return "suicide"
+	case SUPERKO:
+		return "superko"
+	case OWN_EYE:
+		return "fills own eye"
+	case EARLY_PASS:
+		return "early pass"
+	}
+	return fmt.Sprintf("Legality(%d)", int(legality))
+}
+
+// IllegalActionError is returned by Play for an action the rules forbid
+type IllegalActionError struct {
+	Action		int
+	Legality	Legality
+}
+
+func (err *IllegalActionError) Error() string {
+	return fmt.Sprintf("action %d is illegal: %s", err.Action, err.Legality)
+}
+
+// Legality checks whether the current player may take the action, and unlike Step it accepts any action
+func (game *Game) Legality(action int) Legality {
+	if action < 0 || action > game.PassAction() {
+		return OFF_BOARD
+	}
+	if game.Finished() {
+		return GAME_OVER
+	}
+	if action == game.PassAction() {
+		if game.legalActions[len(game.legalActions)-1] != action {
+			return EARLY_PASS
+		}
+		return LEGAL
+	}
+
+	legality := game.boardLegality(action)
+	if legality == OCCUPIED || legality == SUICIDE {
+		return legality
+	}
+	if game.repeatsPosition(action) {
+		return SUPERKO
+	}
+	// without the own_eye filter, filling an own eye is an ordinary move
+	if legality == OWN_EYE && game.options.ActionFilters & OWN_EYE_FILTER == 0 {
+		return LEGAL
+	}
+	return legality
+}
+
+// Play takes the action like Step, but returns an IllegalActionError instead of taking an illegal action
+// filling an own eye is allowed, even when the own_eye filter drops it from the favourable legal actions
+func (game *Game) Play(action int) error {
+	legality := game.Legality(action)
+	if legality != LEGAL && legality != OWN_EYE {
+		return &IllegalActionError{Action: action, Legality: legality}
+	}
+	game.Step(action)
+	return nil
+}
@@ -0,0 +1,232 @@
+package gogame
+
+import (
+	"strings"
+	"testing"
+	"gitlab.com/Habimm/tree-search-golang/config"
+)
+
+func TestLegality(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	game := New(options)
+	// black captures the white stone at 6 and white may not take the ko back
+	for _, action := range []int{1, 6, 5, 2, 11, 8, 20, 12, 7} {
+		game.Step(action)
+	}
+	expected := map[int]Legality{
+		-1: OFF_BOARD,
+		26: OFF_BOARD,
+		1: OCCUPIED,
+		0: SUICIDE,
+		6: SUPERKO,
+		3: LEGAL,
+		25: LEGAL}
+	for action, legality := range expected {
+		if game.Legality(action) != legality {
+			t.Errorf("Action %d is %s instead of %s", action, game.Legality(action), legality)
+		}
+	}
+
+	before := snapshot(game)
+	err := game.Play(6)
+	if illegal, ok := err.(*IllegalActionError); !ok || illegal.Action != 6 || illegal.Legality != SUPERKO {
+		t.Errorf("Playing the ko back gives the error %v", err)
+	}
+	if snapshot(game) != before {
+		t.Errorf("An illegal action changed the game\n%s", game)
+	}
+
+	game.Step(game.PassAction())
+	game.Step(game.PassAction())
+	if game.Legality(3) != GAME_OVER || game.Play(game.PassAction()) == nil {
+		t.Errorf("Action 3 after both players passed is %s", game.Legality(3))
+	}
+}
+
+func TestOwnEye(t *testing.T) {
+	options, setup := rowsSetup([]string{
+		"-O---",
+		"OO---",
+		"-----"})
+	setup.ToMove = config.WHITE
+	game := NewFromSetup(options, setup)
+	if game.Legality(0) != OWN_EYE || contains(game.FavourableLegalActions(), 0) {
+		t.Errorf("Filling the own eye is %s", game.Legality(0))
+	}
+	if err := game.Play(0); err != nil || game.board[0] != config.WHITE {
+		t.Errorf("Filling the own eye gives the error %v", err)
+	}
+
+	// without the own_eye filter, filling the eye is favourable and Legality does not single it out
+	options.ActionFilters &^= OWN_EYE_FILTER
+	game = NewFromSetup(options, setup)
+	if game.Legality(0) != LEGAL || !contains(game.FavourableLegalActions(), 0) {
+		t.Errorf("Without the own_eye filter, filling the own eye is %s", game.Legality(0))
+	}
+	options.ActionFilters |= OWN_EYE_FILTER
+
+	setup.ToMove = config.BLACK
+	game = NewFromSetup(options, setup)
+	if game.Legality(0) != SUICIDE {
+		t.Errorf("Playing into the opponent's eye is %s", game.Legality(0))
+	}
+}
+
+func TestActionFilters(t *testing.T) {
+	options, setup := rowsSetup([]string{
+		"-O---",
+		"OO---",
+		"-----"})
+	eye, selfAtari := options.Point(0, 0), options.Point(2, 0)
+	for _, test := range []struct {
+		filters string
+		toMove int
+		legal bool
+		favourable bool
+		action int
+	}{
+		{"own_eye", config.WHITE, true, false, eye},
+		{"", config.WHITE, true, true, eye},
+		{"own_eye", config.BLACK, true, true, selfAtari},
+		{"own_eye,self_atari", config.BLACK, true, false, selfAtari}} {
+		options.ActionFilters = ActionFiltersFromString(test.filters)
+		setup.ToMove = test.toMove
+		game := NewFromSetup(options, setup)
+		if contains(game.LegalActions(), test.action) != test.legal ||
+			contains(game.FavourableLegalActions(), test.action) != test.favourable {
+			t.Errorf("With filters %q, action %d has legal actions %v and favourable legal actions %v",
+				test.filters, test.action, game.LegalActions(), game.FavourableLegalActions())
+		}
+		if options.ActionFilters == 0 && len(game.LegalActions()) != len(game.FavourableLegalActions()) {
+			t.Errorf("Without filters, the favourable legal actions %v differ from the legal actions %v",
+				game.FavourableLegalActions(), game.LegalActions())
+		}
+	}
+}
+
+func TestNoPassMoves(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	options.NoPassMoves = 2
+	game := New(options)
+	for _, action := range []int{6, 18} {
+		if game.Legality(game.PassAction()) != EARLY_PASS || contains(game.LegalActions(), game.PassAction()) {
+			t.Errorf("Passing after %d moves is %s", game.NumMoves(), game.Legality(game.PassAction()))
+		}
+		if err := game.Play(game.PassAction()); err == nil {
+			t.Errorf("Passing after %d moves gives no error", game.NumMoves())
+		}
+		game.Step(action)
+	}
+	if game.Legality(game.PassAction()) != LEGAL {
+		t.Errorf("Passing after %d moves is %s", game.NumMoves(), game.Legality(game.PassAction()))
+	}
+
+	// Black may pass if filling an own eye is all that is left
+	options, setup := rowsSetup([]string{"-X-"})
+	options.NoPassMoves = 2
+	game = NewFromSetup(options, setup)
+	if game.Legality(game.PassAction()) != LEGAL || len(game.FavourableLegalActions()) != 1 {
+		t.Errorf("With only own eyes to fill, passing is %s and the favourable legal actions are %v",
+			game.Legality(game.PassAction()), game.FavourableLegalActions())
+	}
+}
+
+func TestMaxMoves(t *testing.T) {
+	options, setup := rowsSetup(earlyPassRows)
+	options.MaxMoves = 2
+	options.DeadStonePlayouts = 16
+	for _, test := range []struct {
+		scoring		MaxMovesScoring
+		actions		[]int
+		truncated	bool
+		score		float32
+	}{
+		// Black is to move again after two moves; with every stone alive, the stray stones make both areas neutral,
+		// while estimating removes them, so that Black has 45 points and White 36 plus komi
+		{SCORE_AS_IS, []int{options.Point(8, 0), options.Point(8, 8)}, true, -0.5},
+		{SCORE_ESTIMATED, []int{options.Point(8, 0), options.Point(8, 8)}, true, 8.5},
+		{SCORE_DRAW, []int{options.Point(8, 0), options.Point(8, 8)}, true, 0.0},
+		{SCORE_DRAW, []int{options.PassAction(), options.PassAction()}, false, -0.5},
+	} {
+		options.MaxMovesScoring = test.scoring
+		game := NewFromSetup(options, setup)
+		for _, action := range test.actions {
+			game.Step(action)
+		}
+		if !game.Finished() || game.Truncated() != test.truncated || game.Legality(0) != GAME_OVER {
+			t.Errorf("After %v, the game is finished %t and truncated %t", test.actions, game.Finished(), game.Truncated())
+		}
+		if game.Score() != test.score {
+			t.Errorf("After %v with scoring %d, the score is %.1f instead of %.1f", test.actions, test.scoring, game.Score(), test.score)
+		}
+		game.Undo()
+		if game.Finished() || game.Truncated() {
+			t.Errorf("After taking back the last of %v, the game is still over", test.actions)
+		}
+	}
+}
+
+func TestPassAliveCleanup(t *testing.T) {
+	options, setup := rowsSetup(earlyPassRows)
+	options.Cleanup = PASS_ALIVE_CLEANUP
+	game := NewFromSetup(options, setup)
+	pass := game.PassAction()
+
+	// the stones are not pass-alive, so the first two passes start the cleanup phase
+	game.Step(pass)
+	game.Step(pass)
+	if game.Finished() || !game.InCleanup() || game.Legality(pass) != LEGAL {
+		t.Errorf("After the first two passes, the game is finished %t and in cleanup %t", game.Finished(), game.InCleanup())
+	}
+	hash := game.Hash()
+	game.Step(options.Point(2, 0))
+	game.Step(pass)
+	game.Undo()
+	game.Undo()
+	if game.Hash() != hash || !game.InCleanup() || game.Finished() {
+		t.Errorf("Taking back actions in the cleanup phase does not restore it")
+	}
+	// a transformed game keeps the cleanup phase in its differences
+	game.Step(options.Point(2, 0))
+	transformed := game.Transform(Symmetry(mirrorRow))
+	transformed.Undo()
+	if !transformed.InCleanup() {
+		t.Errorf("Taking back an action of a transformed game in the cleanup phase leaves it")
+	}
+	game.Undo()
+
+	game.Step(pass)
+	game.Step(pass)
+	if !game.Finished() || !game.InCleanup() {
+		t.Errorf("The next two passes do not end the cleanup phase")
+	}
+	for game.NumMoves() > 0 {
+		game.Undo()
+	}
+	if game.InCleanup() || game.Hash() != game.computeHash() {
+		t.Errorf("Taking back all actions does not leave the cleanup phase")
+	}
+
+	// without stones, everything is settled, and the first two passes end the game
+	game = New(options)
+	game.Step(pass)
+	game.Step(pass)
+	if !game.Finished() || game.InCleanup() {
+		t.Errorf("On an empty board, the first two passes lead to cleanup %t", game.InCleanup())
+	}
+}
+
+func TestActionFiltersFromString(t *testing.T) {
+	filters := ActionFiltersFromString(" ladder_escape,own_eye ")
+	if filters != OWN_EYE_FILTER | LADDER_ESCAPE_FILTER || strings.Join(filters.Names(), ",") != "own_eye,ladder_escape" {
+		t.Errorf("The filters are %b with the names %v", filters, filters.Names())
+	}
+	if ActionFiltersFromString("") != 0 || len(ActionFilters(0).Names()) != 0 {
+		t.Errorf("The empty string selects the filters %b", ActionFiltersFromString(""))
+	}
+	if DefaultOptions() != DefaultOptions() {
+		t.Errorf("Equal options do not compare equal")
+	}
+}
@@ -0,0 +1,233 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"fmt"
+)
+
+// KoRule selects which earlier positions a move may not recreate
+type KoRule int
+
+const (
+	POSITIONAL_SUPERKO KoRule = iota // no move may recreate an earlier board
+	SITUATIONAL_SUPERKO              // no move may recreate an earlier board with the same player to move
+)
+
+// Rules selects how a finished game is scored
+type Rules int
+
+const (
+	TROMP_TAYLOR Rules = iota // area scoring where every stone on the board counts as alive
+	CHINESE                   // area scoring: stones plus surrounded empty regions
+	JAPANESE                  // territory scoring: surrounded empty regions plus prisoners
+)
+
+// DeadStoneRule selects which stones count as alive when a finished game is scored
+type DeadStoneRule int
+
+const (
+	ALL_ALIVE DeadStoneRule = iota // every stone on the board is alive
+	ESTIMATE_DEAD_STONES        // dead stones are estimated by Benson's algorithm, seki detection and random playouts
+)
+
+// MaxMovesScoring selects how a game that ended by reaching the maximum number of moves is scored
+type MaxMovesScoring int
+
+const (
+	SCORE_AS_IS MaxMovesScoring = iota // score the position as if both players had passed, under the dead stone rule
+	SCORE_ESTIMATED                    // remove the estimated dead stones before scoring, whatever the dead stone rule
+	SCORE_DRAW                         // the game is a draw
+)
+
+// CleanupRule selects what happens after the first two passes in a row
+type CleanupRule int
+
+const (
+	NO_CLEANUP CleanupRule = iota // the game ends
+	PASS_ALIVE_CLEANUP            // unless every stone is pass-alive, the game goes on until the next two passes in a row
+)
+
+// Options fix the board and the rules of a game; every game carries its own
+type Options struct {
+	Width		int // number of columns
+	Height		int // number of rows
+	Komi		float32
+	HistorySize	int // number of positions in an observation, including the current one
+	KoRule		KoRule
+	Rules		Rules
+	FeatureSet	string // name of the FEATURE_SETS entry that observations are made of
+	DeadStones	DeadStoneRule
+	DeadStonePlayouts int // number of random playouts that estimate dead stones
+	ActionFilters	ActionFilters // the filters that favourable legal actions pass
+	MaxMoves	int // number of moves after which the game ends, or 0 for no limit
+	MaxMovesScoring	MaxMovesScoring
+	NoPassMoves	int // number of moves at the start in which a player may pass only if no board action is favourable
+	Cleanup		CleanupRule
+}
+
+// DefaultOptions reads the options from the configuration
+func DefaultOptions() Options {
+	return Options{
+		Width: config.Int["boardsize"],
+		Height: config.Int["boardsize"],
+		Komi: config.Float["komi"],
+		HistorySize: config.Int["history_size"],
+		KoRule: KoRuleFromString(config.String["ko_rule"]),
+		Rules: RulesFromString(config.String["rules"]),
+		FeatureSet: config.String["feature_set"],
+		DeadStones: DeadStonesFromString(config.String["dead_stones"]),
+		DeadStonePlayouts: config.Int["dead_stone_playouts"],
+		ActionFilters: ActionFiltersFromString(config.String["action_filters"]),
+		MaxMoves: config.Int["max_moves"],
+		MaxMovesScoring: MaxMovesScoringFromString(config.String["max_moves_scoring"]),
+		NoPassMoves: config.Int["no_pass_moves"],
+		Cleanup: CleanupFromString(config.String["cleanup"])}
+}
+
+// NumPoints is the number of intersections of the board
+func (options Options) NumPoints() int {
+	return options.Width * options.Height
+}
+
+// PassAction is the action that passes, which comes after all board actions
+func (options Options) PassAction() int {
+	return options.NumPoints()
+}
+
+// NumActions is the number of board actions plus one for passing
+func (options Options) NumActions() int {
+	return options.NumPoints() + 1
+}
+
+// Point is the board action at the given row and column, counted from the top left corner
+func (options Options) Point(row int, column int) int {
+	return row * options.Width + column
+}
+
+// Coordinates are the row and the column of a board action
+func (options Options) Coordinates(action int) (int, int) {
+	return action / options.Width, action % options.Width
+}
+
+// Square says whether the board has as many rows as columns
+func (options Options) Square() bool {
+	return options.Width == options.Height
+}
+
+func KoRuleFromString(name string) KoRule {
+	switch name {
+	case "positional":
+		return POSITIONAL_SUPERKO
+	case "situational":
+		return SITUATIONAL_SUPERKO
+	}
+	log.Panicf("Unaccepted ko rule %s (only positional and situational)", name)
+	panic(0)
+}
+
+// koRuleName is the name KoRuleFromString accepts for the ko rule
+func koRuleName(koRule KoRule) string {
+	if koRule == SITUATIONAL_SUPERKO {
+		return "situational"
+	}
+	return "positional"
+}
+
+func RulesFromString(name string) Rules {
+	switch name {
+	case "tromp-taylor":
+		return TROMP_TAYLOR
+	case "chinese":
+		return CHINESE
+	case "japanese":
+		return JAPANESE
+	}
+	log.Panicf("Unaccepted rules %s (only tromp-taylor, chinese and japanese)", name)
+	panic(0)
+}
+
+// rulesName is the name RulesFromString accepts for the rules
+func rulesName(rules Rules) string {
+	switch rules {
+	case CHINESE:
+		return "chinese"
+	case JAPANESE:
+		return "japanese"
+	}
+	return "tromp-taylor"
+}
+
+func DeadStonesFromString(name string) DeadStoneRule {
+	switch name {
+	case "all-alive":
+		return ALL_ALIVE
+	case "estimate":
+		return ESTIMATE_DEAD_STONES
+	}
+	log.Panicf("Unaccepted dead stone mode %s (only all-alive and estimate)", name)
+	panic(0)
+}
+
+// deadStonesName is the name DeadStonesFromString accepts for the dead stone rule
+func deadStonesName(deadStones DeadStoneRule) string {
+	if deadStones == ESTIMATE_DEAD_STONES {
+		return "estimate"
+	}
+	return "all-alive"
+}
+
+func MaxMovesScoringFromString(name string) MaxMovesScoring {
+	switch name {
+	case "score":
+		return SCORE_AS_IS
+	case "estimate":
+		return SCORE_ESTIMATED
+	case "draw":
+		return SCORE_DRAW
+	}
+	log.Panicf("Unaccepted scoring at the maximum number of moves %s (only score, estimate and draw)", name)
+	panic(0)
+}
+
+// maxMovesScoringName is the name MaxMovesScoringFromString accepts for the scoring at the maximum number of moves
+func maxMovesScoringName(scoring MaxMovesScoring) string {
+	switch scoring {
+	case SCORE_ESTIMATED:
+		return "estimate"
+	case SCORE_DRAW:
+		return "draw"
+	}
+	return "score"
+}
+
+func CleanupFromString(name string) CleanupRule {
+	switch name {
+	case "none":
+		return NO_CLEANUP
+	case "pass-alive":
+		return PASS_ALIVE_CLEANUP
+	}
+	log.Panicf("Unaccepted cleanup %s (only none and pass-alive)", name)
+	panic(0)
+}
+
+// cleanupName is the name CleanupFromString accepts for the cleanup rule
+func cleanupName(cleanup CleanupRule) string {
+	if cleanup == PASS_ALIVE_CLEANUP {
+		return "pass-alive"
+	}
+	return "none"
+}
+
+// String gives the name of the rules as written in the SGF RU property
+func (rules Rules) String() string {
+	switch rules {
+	case TROMP_TAYLOR:
+		return "Tromp-Taylor"
+	case CHINESE:
+		return "Chinese"
+	case JAPANESE:
+		return "Japanese"
+	}
+	return fmt.Sprintf("Rules(%d)", int(rules))
+}
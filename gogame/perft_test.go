@@ -0,0 +1,76 @@
+package gogame
+
+import (
+	"testing"
+)
+
+/**
+	perft counts the positions reached by every sequence of exactly depth legal actions
+	finished games that are reached earlier do not count, just as checkmates before the last ply do not count in chess
+	it walks the game tree with Step and Undo, so that it exercises taking back actions as much as playing them
+*/
+func perft(game *Game, depth int) int {
+	if depth == 0 {
+		return 1
+	}
+	leaves := 0
+	for _, action := range append([]int{}, game.LegalActions()...) {
+		game.Step(action)
+		leaves += perft(game, depth-1)
+		game.Undo()
+	}
+	return leaves
+}
+
+// referencePerft counts the same as perft on the reference rules engine
+func referencePerft(reference *referenceGame, depth int) int {
+	if depth == 0 {
+		return 1
+	}
+	leaves := 0
+	for _, action := range reference.legalActions() {
+		leaves += referencePerft(reference.play(action), depth-1)
+	}
+	return leaves
+}
+
+func TestPerft(t *testing.T) {
+	cases := []struct {
+		width, height	int
+		koRule		KoRule
+		depth		int
+		leaves		int
+	}{
+		// from the empty 3x3 board, Black has 9 points and a pass, then White has 8 points and a pass after a stone,
+		// or 9 points and a pass after a pass
+		{3, 3, POSITIONAL_SUPERKO, 1, 10},
+		{3, 3, POSITIONAL_SUPERKO, 2, 91},
+		{2, 2, POSITIONAL_SUPERKO, 9, 4184},
+		{2, 2, SITUATIONAL_SUPERKO, 9, 4544},
+		{3, 3, POSITIONAL_SUPERKO, 4, 5281},
+		{4, 3, POSITIONAL_SUPERKO, 3, 1740},
+	}
+	for _, c := range cases {
+		options := DefaultOptions()
+		options.Width, options.Height = c.width, c.height
+		options.KoRule = c.koRule
+		game := New(options)
+		leaves := perft(game, c.depth)
+		referenceLeaves := referencePerft(newReference(options), c.depth)
+		if leaves != c.leaves || referenceLeaves != c.leaves {
+			t.Errorf("Perft %d on %dx%d with ko rule %d counts %d leaves, the reference %d, instead of %d",
+				c.depth, c.width, c.height, c.koRule, leaves, referenceLeaves, c.leaves)
+		}
+		if game.NumMoves() != 0 {
+			t.Errorf("Perft left %d actions on the game", game.NumMoves())
+		}
+	}
+}
+
+func BenchmarkPerft(b *testing.B) {
+	options := DefaultOptions()
+	options.Width, options.Height = 3, 3
+	for i := 0; i < b.N; i++ {
+		perft(New(options), 4)
+	}
+}
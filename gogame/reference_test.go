@@ -0,0 +1,285 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+/**
+	referenceGame is a slow rules engine that is meant to be obviously correct rather than fast
+	it keeps the board as a grid of rows, finds chains and liberties by flood fill on every question,
+	remembers every earlier position as a string and copies itself for every action it plays
+	the tests play random games on it and on Game side by side and compare them after every action
+*/
+type referenceGame struct {
+	width, height	int
+	komi		float32
+	koRule		KoRule
+	rules		Rules
+	grid		[][]int
+	toMove		int
+	passes		int // the number of passes in a row that led to this position
+	prisoners	[3]int
+	seen		map[string]bool // the keys of all positions so far, this one included
+}
+
+func newReference(options Options) *referenceGame {
+	reference := &referenceGame{
+		width: options.Width,
+		height: options.Height,
+		komi: options.Komi,
+		koRule: options.KoRule,
+		rules: options.Rules,
+		toMove: config.BLACK,
+		seen: make(map[string]bool)}
+	for row := 0; row < options.Height; row++ {
+		reference.grid = append(reference.grid, make([]int, options.Width))
+	}
+	reference.seen[reference.key()] = true
+	return reference
+}
+
+func (reference *referenceGame) copy() *referenceGame {
+	copied := *reference
+	copied.grid = nil
+	for _, row := range reference.grid {
+		copied.grid = append(copied.grid, append([]int{}, row...))
+	}
+	return &copied
+}
+
+// key identifies a position for the ko rule
+func (reference *referenceGame) key() string {
+	key := fmt.Sprint(reference.grid)
+	if reference.koRule == SITUATIONAL_SUPERKO {
+		key += fmt.Sprint(reference.toMove)
+	}
+	return key
+}
+
+func (reference *referenceGame) neighbours(row int, column int) (neighbours [][2]int) {
+	for _, step := range [4][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		r, c := row + step[0], column + step[1]
+		if r >= 0 && r < reference.height && c >= 0 && c < reference.width {
+			neighbours = append(neighbours, [2]int{r, c})
+		}
+	}
+	return
+}
+
+// area floods from the given point over all points of the same color,
+// and collects the colors of the points that border the flooded area
+func (reference *referenceGame) area(row int, column int) (points [][2]int, bordering map[int]bool) {
+	color := reference.grid[row][column]
+	visited := map[[2]int]bool{{row, column}: true}
+	points = [][2]int{{row, column}}
+	bordering = make(map[int]bool)
+	for p := 0; p < len(points); p++ {
+		for _, neigh := range reference.neighbours(points[p][0], points[p][1]) {
+			if reference.grid[neigh[0]][neigh[1]] != color {
+				bordering[reference.grid[neigh[0]][neigh[1]]] = true
+			} else if !visited[neigh] {
+				visited[neigh] = true
+				points = append(points, neigh)
+			}
+		}
+	}
+	return
+}
+
+// play returns the game after the given action, or nil if the action is illegal
+func (reference *referenceGame) play(action int) *referenceGame {
+	next := reference.result(action)
+	if next == nil {
+		return nil
+	}
+	next.seen = map[string]bool{next.key(): true}
+	for key := range reference.seen {
+		next.seen[key] = true
+	}
+	return next
+}
+
+// result is the game after the given action, or nil if the action is illegal,
+// except that the new position still shares the earlier positions with this one
+func (reference *referenceGame) result(action int) *referenceGame {
+	if reference.passes >= 2 || action < 0 || action > reference.width * reference.height {
+		return nil
+	}
+	next := reference.copy()
+	next.toMove = other(reference.toMove)
+	if action == reference.width * reference.height {
+		next.passes++
+		return next
+	}
+	next.passes = 0
+
+	row, column := action / reference.width, action % reference.width
+	if next.grid[row][column] != EMPTY {
+		return nil
+	}
+	next.grid[row][column] = reference.toMove
+	for _, neigh := range next.neighbours(row, column) {
+		if next.grid[neigh[0]][neigh[1]] != next.toMove {
+			continue
+		}
+		chain, bordering := next.area(neigh[0], neigh[1])
+		if !bordering[EMPTY] {
+			for _, stone := range chain {
+				next.grid[stone[0]][stone[1]] = EMPTY
+			}
+			next.prisoners[reference.toMove] += len(chain)
+		}
+	}
+	if _, bordering := next.area(row, column); !bordering[EMPTY] {
+		return nil // suicide
+	}
+	if reference.seen[next.key()] {
+		return nil // superko
+	}
+	return next
+}
+
+func (reference *referenceGame) legalActions() (legal []int) {
+	for action := 0; action <= reference.width * reference.height; action++ {
+		if reference.result(action) != nil {
+			legal = append(legal, action)
+		}
+	}
+	return
+}
+
+// score counts as Score does with every stone alive
+func (reference *referenceGame) score() float32 {
+	var points [3]float32
+	for row := range reference.grid {
+		for column, color := range reference.grid[row] {
+			if color != EMPTY {
+				if reference.rules != JAPANESE {
+					points[color]++
+				}
+				continue
+			}
+			_, bordering := reference.area(row, column)
+			if bordering[config.BLACK] && !bordering[config.WHITE] {
+				points[config.BLACK]++
+			} else if bordering[config.WHITE] && !bordering[config.BLACK] {
+				points[config.WHITE]++
+			}
+		}
+	}
+	if reference.rules == JAPANESE {
+		points[config.BLACK] += float32(reference.prisoners[config.BLACK])
+		points[config.WHITE] += float32(reference.prisoners[config.WHITE])
+	}
+	points[config.WHITE] += reference.komi
+	return points[reference.toMove] - points[other(reference.toMove)]
+}
+
+// compareWithReference fails the test at the first thing the game and the reference disagree on
+func compareWithReference(t *testing.T, context string, game *Game, reference *referenceGame) {
+	t.Helper()
+	for row := range reference.grid {
+		for column, color := range reference.grid[row] {
+			if game.board[game.options.Point(row, column)] != color {
+				t.Fatalf("%s: the board differs at row %d and column %d from the reference:\n%s\n%v",
+					context, row, column, game, reference.grid)
+			}
+		}
+	}
+	if game.Color() != reference.toMove {
+		t.Fatalf("%s: %d is to move instead of %d", context, game.Color(), reference.toMove)
+	}
+	for _, color := range [2]int{config.BLACK, config.WHITE} {
+		if game.Prisoners(color) != reference.prisoners[color] {
+			t.Fatalf("%s: %d has %d prisoners instead of %d", context, color, game.Prisoners(color), reference.prisoners[color])
+		}
+	}
+
+	legal := append([]int{}, game.LegalActions()...)
+	sort.Ints(legal)
+	referenceLegal := reference.legalActions()
+	if fmt.Sprint(legal) != fmt.Sprint(referenceLegal) {
+		t.Fatalf("%s: the legal actions %v differ from the reference's %v\n%s", context, legal, referenceLegal, game)
+	}
+	isLegal := make(map[int]bool)
+	for _, action := range referenceLegal {
+		isLegal[action] = true
+	}
+	for action := 0; action < game.NumActions(); action++ {
+		legality := game.Legality(action)
+		if (legality == LEGAL || legality == OWN_EYE) != isLegal[action] {
+			t.Fatalf("%s: the legality of %d is %s, but the reference says %t", context, action, legality, isLegal[action])
+		}
+	}
+	if game.Finished() != (len(referenceLegal) == 0) {
+		t.Fatalf("%s: the game is finished %t, but the reference has the legal actions %v", context, game.Finished(), referenceLegal)
+	}
+	if game.Score() != reference.score() {
+		t.Fatalf("%s: the score %.1f differs from the reference's %.1f", context, game.Score(), reference.score())
+	}
+}
+
+// playAgainstReference plays a random game on a board of the given size, with an occasional undo,
+// and compares the game with the reference after every action
+func playAgainstReference(t *testing.T, seed int64, width int, height int, koRule KoRule, rules Rules) {
+	options := DefaultOptions()
+	options.Width, options.Height = width, height
+	options.Komi = 0.5
+	options.KoRule = koRule
+	options.Rules = rules
+	options.DeadStones = ALL_ALIVE
+	// the reference knows no maximum number of moves
+	options.MaxMoves = 0
+	random := rand.New(rand.NewSource(seed))
+
+	game := New(options)
+	references := []*referenceGame{newReference(options)}
+	for a := 0; a < 4 * options.NumPoints() && !game.Finished(); a++ {
+		reference := references[len(references)-1]
+		context := fmt.Sprintf("seed %d on %dx%d after %d actions", seed, width, height, game.NumMoves())
+		compareWithReference(t, context, game, reference)
+
+		if game.NumMoves() > 0 && random.Intn(10) == 0 {
+			game.Undo()
+			references = references[:len(references)-1]
+			continue
+		}
+		legal := reference.legalActions()
+		action := legal[len(legal)-1] // the pass comes last
+		if len(legal) > 1 && random.Intn(20) != 0 {
+			action = legal[random.Intn(len(legal)-1)]
+		}
+		game.Step(action)
+		references = append(references, reference.play(action))
+	}
+	compareWithReference(t, fmt.Sprintf("seed %d on %dx%d at the end", seed, width, height), game, references[len(references)-1])
+}
+
+func TestAgainstReference(t *testing.T) {
+	sizes := [][2]int{{2, 2}, {3, 3}, {4, 4}, {5, 5}, {4, 3}, {7, 5}, {6, 6}}
+	for seed := int64(0); seed < 140; seed++ {
+		size := sizes[seed % int64(len(sizes))]
+		koRule := KoRule(seed / int64(len(sizes)) % 2)
+		rules := Rules(seed / int64(2 * len(sizes)) % 3)
+		playAgainstReference(t, seed, size[0], size[1], koRule, rules)
+	}
+}
+
+// FuzzAgainstReference lets the fuzzer pick the seed, the board size and the rules, as in
+// go test -fuzz FuzzAgainstReference ./gogame
+func FuzzAgainstReference(f *testing.F) {
+	f.Add(int64(1), uint8(3), uint8(3), false, uint8(0))
+	f.Add(int64(2), uint8(5), uint8(4), true, uint8(2))
+	f.Add(int64(81), uint8(0), uint8(0), false, uint8(0)) // on 1x1, the only point is suicide
+	f.Fuzz(func(t *testing.T, seed int64, width uint8, height uint8, situational bool, rules uint8) {
+		koRule := POSITIONAL_SUPERKO
+		if situational {
+			koRule = SITUATIONAL_SUPERKO
+		}
+		playAgainstReference(t, seed, int(width % 9) + 1, int(height % 9) + 1, koRule, Rules(rules % 3))
+	})
+}
@@ -0,0 +1,409 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+/**
+	a game is saved as its options, its current board, the player to move, the pass and cleanup state, the prisoners,
+	the handicap, the number of moves played and the whole stack of board differences
+	everything else, like the chains, the hashes and the legal actions, is recomputed when loading
+	the differences beyond the number of moves lead to positions before the start, which Undo cannot reach
+*/
+type gameState struct {
+	Options		optionsState		`json:"options"`
+	Board		[]string		`json:"board"` // one row per string: X for black, O for white, - for empty
+	Color		int			`json:"color"`
+	LastPass	bool			`json:"last_pass"`
+	Cleanup		bool			`json:"cleanup"`
+	Prisoners	[2]int			`json:"prisoners"` // captured by black, captured by white
+	Handicap	int			`json:"handicap"`
+	NumMoves	int			`json:"num_moves"`
+	Differences	[]differenceState	`json:"differences"` // the oldest first
+}
+
+type optionsState struct {
+	Width			int		`json:"width"`
+	Height			int		`json:"height"`
+	Komi			float32		`json:"komi"`
+	HistorySize		int		`json:"history_size"`
+	KoRule			string		`json:"ko_rule"`
+	Rules			string		`json:"rules"`
+	FeatureSet		string		`json:"feature_set"`
+	DeadStones		string		`json:"dead_stones"`
+	DeadStonePlayouts	int		`json:"dead_stone_playouts"`
+	ActionFilters		[]string	`json:"action_filters"`
+	MaxMoves		int		`json:"max_moves"`
+	MaxMovesScoring		string		`json:"max_moves_scoring"`
+	NoPassMoves		int		`json:"no_pass_moves"`
+	Cleanup			string		`json:"cleanup"`
+}
+
+type differenceState struct {
+	Action		int		`json:"action"`
+	Removed		int		`json:"removed"` // the stone to remove to get the previous position, -1 if none
+	Added		[][2]int	`json:"added,omitempty"` // the position and color of each stone to put back
+	LastPass	bool		`json:"last_pass"`
+	Cleanup		bool		`json:"cleanup"`
+}
+
+// binaryVersion is the first byte of every binary form; it changes whenever the binary layout does
+const binaryVersion = 2
+
+func (game *Game) state() gameState {
+	options := game.options
+	state := gameState{
+		Options: optionsState{
+			Width: options.Width,
+			Height: options.Height,
+			Komi: options.Komi,
+			HistorySize: options.HistorySize,
+			KoRule: koRuleName(options.KoRule),
+			Rules: rulesName(options.Rules),
+			FeatureSet: options.FeatureSet,
+			DeadStones: deadStonesName(options.DeadStones),
+			DeadStonePlayouts: options.DeadStonePlayouts,
+			ActionFilters: options.ActionFilters.Names(),
+			MaxMoves: options.MaxMoves,
+			MaxMovesScoring: maxMovesScoringName(options.MaxMovesScoring),
+			NoPassMoves: options.NoPassMoves,
+			Cleanup: cleanupName(options.Cleanup)},
+		Color: game.currentColor,
+		LastPass: game.lastPass,
+		Cleanup: game.cleanup,
+		Prisoners: [2]int{game.prisoners[config.BLACK], game.prisoners[config.WHITE]},
+		Handicap: game.handicap,
+		NumMoves: game.NumMoves(),
+		Differences: make([]differenceState, len(game.differences))}
+
+	for row := 0; row < options.Height; row++ {
+		line := make([]byte, options.Width)
+		for column := range line {
+			line[column] = "-XO"[game.board[options.Point(row, column)]]
+		}
+		state.Board = append(state.Board, string(line))
+	}
+
+	for d, diff := range game.differences {
+		state.Differences[d] = differenceState{
+			Action: diff.action, Removed: diff.rem, LastPass: diff.lastPass, Cleanup: diff.cleanup}
+		for pos, color := range diff.add {
+			state.Differences[d].Added = append(state.Differences[d].Added, [2]int{pos, color})
+		}
+		sort.Slice(state.Differences[d].Added, func(i, j int) bool {
+			return state.Differences[d].Added[i][0] < state.Differences[d].Added[j][0]
+		})
+	}
+	return state
+}
+
+// newFromState checks a saved game and recomputes everything that was not saved
+func newFromState(state gameState) (*Game, error) {
+	options, err := state.Options.options()
+	if err != nil {
+		return nil, err
+	}
+	if len(state.Board) != options.Height {
+		return nil, fmt.Errorf("board has %d rows instead of %d", len(state.Board), options.Height)
+	}
+	if state.Color != config.BLACK && state.Color != config.WHITE {
+		return nil, fmt.Errorf("player to move %d is neither black nor white", state.Color)
+	}
+	if state.NumMoves < 0 || state.NumMoves > len(state.Differences) {
+		return nil, fmt.Errorf("%d moves do not fit %d differences", state.NumMoves, len(state.Differences))
+	}
+
+	game := newEmpty(options, make([]boardDifference, len(state.Differences)))
+	for row, line := range state.Board {
+		if len(line) != options.Width {
+			return nil, fmt.Errorf("board row %d has %d columns instead of %d", row, len(line), options.Width)
+		}
+		for column := range line {
+			switch line[column] {
+			case 'X':
+				game.board[options.Point(row, column)] = config.BLACK
+			case 'O':
+				game.board[options.Point(row, column)] = config.WHITE
+			case '-':
+			default:
+				return nil, fmt.Errorf("board row %d has the unknown point %q", row, line[column])
+			}
+		}
+	}
+
+	onBoard := func(pos int) bool { return pos >= 0 && pos < options.NumPoints() }
+	for d, diffState := range state.Differences {
+		if diffState.Action < 0 || diffState.Action > options.PassAction() ||
+			(diffState.Removed != UNDEF && !onBoard(diffState.Removed)) {
+			return nil, fmt.Errorf("difference %d is off the board", d)
+		}
+		diff := boardDifference{
+			action: diffState.Action, rem: diffState.Removed, lastPass: diffState.LastPass, cleanup: diffState.Cleanup}
+		for _, added := range diffState.Added {
+			if !onBoard(added[0]) || (added[1] != config.BLACK && added[1] != config.WHITE) {
+				return nil, fmt.Errorf("difference %d adds an invalid stone %v", d, added)
+			}
+			if diff.add == nil {
+				diff.add = make(map[int]int, len(diffState.Added))
+			}
+			diff.add[added[0]] = added[1]
+		}
+		game.differences[d] = diff
+	}
+
+	game.currentColor = state.Color
+	game.lastPass = state.LastPass
+	game.cleanup = state.Cleanup
+	game.prisoners[config.BLACK], game.prisoners[config.WHITE] = state.Prisoners[0], state.Prisoners[1]
+	game.handicap = state.Handicap
+	game.rebuildChains()
+	for pos := range game.board {
+		if game.chainHead[pos] == pos && game.chainLiberties[pos] == 0 {
+			return nil, fmt.Errorf("chain at %d has no liberties", pos)
+		}
+	}
+	game.hash = game.computeHash()
+	game.recomputePositionHashes(state.NumMoves+1)
+	game.refreshLegalActions()
+	return game, nil
+}
+
+func (state optionsState) options() (options Options, err error) {
+	options = Options{
+		Width: state.Width,
+		Height: state.Height,
+		Komi: state.Komi,
+		HistorySize: state.HistorySize,
+		FeatureSet: state.FeatureSet,
+		DeadStonePlayouts: state.DeadStonePlayouts,
+		MaxMoves: state.MaxMoves,
+		NoPassMoves: state.NoPassMoves}
+	if options.Width < 1 || options.Height < 1 || options.Width > 52 || options.Height > 52 {
+		return options, fmt.Errorf("board size %dx%d is not between 1x1 and 52x52", options.Width, options.Height)
+	}
+	if options.HistorySize < 1 {
+		return options, fmt.Errorf("history size %d is less than 1", options.HistorySize)
+	}
+	if _, present := FEATURE_SETS[options.FeatureSet]; !present {
+		return options, fmt.Errorf("unknown feature set %s", options.FeatureSet)
+	}
+	var unknown string
+	if options.ActionFilters, unknown = actionFiltersFromNames(state.ActionFilters); unknown != "" {
+		return options, fmt.Errorf("unknown action filter %s", unknown)
+	}
+
+	// look the names up among the names of all values, since the FromString functions panic on unknown names
+	found := 0
+	for koRule := POSITIONAL_SUPERKO; koRule <= SITUATIONAL_SUPERKO; koRule++ {
+		if koRuleName(koRule) == state.KoRule {
+			options.KoRule = koRule
+			found++
+		}
+	}
+	for rules := TROMP_TAYLOR; rules <= JAPANESE; rules++ {
+		if rulesName(rules) == state.Rules {
+			options.Rules = rules
+			found++
+		}
+	}
+	for deadStones := ALL_ALIVE; deadStones <= ESTIMATE_DEAD_STONES; deadStones++ {
+		if deadStonesName(deadStones) == state.DeadStones {
+			options.DeadStones = deadStones
+			found++
+		}
+	}
+	for scoring := SCORE_AS_IS; scoring <= SCORE_DRAW; scoring++ {
+		if maxMovesScoringName(scoring) == state.MaxMovesScoring {
+			options.MaxMovesScoring = scoring
+			found++
+		}
+	}
+	for cleanup := NO_CLEANUP; cleanup <= PASS_ALIVE_CLEANUP; cleanup++ {
+		if cleanupName(cleanup) == state.Cleanup {
+			options.Cleanup = cleanup
+			found++
+		}
+	}
+	if found != 5 {
+		return options, fmt.Errorf("unknown ko rule %s, rules %s, dead stone rule %s, scoring at the maximum number of moves %s or cleanup %s",
+			state.KoRule, state.Rules, state.DeadStones, state.MaxMovesScoring, state.Cleanup)
+	}
+	return options, nil
+}
+
+// MarshalJSON writes the game in a stable JSON form that can be read by UnmarshalJSON
+func (game *Game) MarshalJSON() ([]byte, error) {
+	return json.Marshal(game.state())
+}
+
+// UnmarshalJSON replaces the game by one read from the JSON form that MarshalJSON writes
+func (game *Game) UnmarshalJSON(data []byte) error {
+	var state gameState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	loaded, err := newFromState(state)
+	if err != nil {
+		return err
+	}
+	*game = *loaded
+	return nil
+}
+
+// MarshalBinary writes the game in a compact form that can be read by UnmarshalBinary
+func (game *Game) MarshalBinary() ([]byte, error) {
+	state := game.state()
+	data := []byte{binaryVersion}
+	putInt := func(value int) { data = binary.AppendVarint(data, int64(value)) }
+	putString := func(value string) {
+		putInt(len(value))
+		data = append(data, value...)
+	}
+	putBool := func(value bool) {
+		if value {
+			putInt(1)
+		} else {
+			putInt(0)
+		}
+	}
+
+	options := state.Options
+	putInt(options.Width)
+	putInt(options.Height)
+	data = binary.LittleEndian.AppendUint32(data, math.Float32bits(options.Komi))
+	putInt(options.HistorySize)
+	putString(options.KoRule)
+	putString(options.Rules)
+	putString(options.FeatureSet)
+	putString(options.DeadStones)
+	putInt(options.DeadStonePlayouts)
+	putInt(len(options.ActionFilters))
+	for _, name := range options.ActionFilters {
+		putString(name)
+	}
+	putInt(options.MaxMoves)
+	putString(options.MaxMovesScoring)
+	putInt(options.NoPassMoves)
+	putString(options.Cleanup)
+
+	for _, line := range state.Board {
+		data = append(data, line...)
+	}
+	putInt(state.Color)
+	putBool(state.LastPass)
+	putBool(state.Cleanup)
+	putInt(state.Prisoners[0])
+	putInt(state.Prisoners[1])
+	putInt(state.Handicap)
+	putInt(state.NumMoves)
+	putInt(len(state.Differences))
+	for _, diff := range state.Differences {
+		putInt(diff.Action)
+		putInt(diff.Removed)
+		putBool(diff.LastPass)
+		putBool(diff.Cleanup)
+		putInt(len(diff.Added))
+		for _, added := range diff.Added {
+			putInt(added[0])
+			putInt(added[1])
+		}
+	}
+	return data, nil
+}
+
+var errBinaryTruncated = errors.New("binary game is truncated")
+
+// UnmarshalBinary replaces the game by one read from the binary form that MarshalBinary writes
+func (game *Game) UnmarshalBinary(data []byte) (err error) {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return fmt.Errorf("binary game does not start with version %d", binaryVersion)
+	}
+	data = data[1:]
+	getInt := func() int {
+		value, n := binary.Varint(data)
+		if n <= 0 {
+			err = errBinaryTruncated
+			return 0
+		}
+		data = data[n:]
+		return int(value)
+	}
+	getBytes := func(length int) []byte {
+		if length < 0 || length > len(data) {
+			err = errBinaryTruncated
+			return nil
+		}
+		value := data[:length]
+		data = data[length:]
+		return value
+	}
+	getString := func() string { return string(getBytes(getInt())) }
+	getBool := func() bool { return getInt() != 0 }
+
+	var state gameState
+	options := &state.Options
+	options.Width = getInt()
+	options.Height = getInt()
+	if komi := getBytes(4); komi != nil {
+		options.Komi = math.Float32frombits(binary.LittleEndian.Uint32(komi))
+	}
+	options.HistorySize = getInt()
+	options.KoRule = getString()
+	options.Rules = getString()
+	options.FeatureSet = getString()
+	options.DeadStones = getString()
+	options.DeadStonePlayouts = getInt()
+	numFilters := getInt()
+	for f := 0; f < numFilters && err == nil; f++ {
+		options.ActionFilters = append(options.ActionFilters, getString())
+	}
+	options.MaxMoves = getInt()
+	options.MaxMovesScoring = getString()
+	options.NoPassMoves = getInt()
+	options.Cleanup = getString()
+	if err != nil {
+		return err
+	}
+	if options.Width < 1 || options.Height < 1 || options.Width > 52 || options.Height > 52 {
+		return fmt.Errorf("board size %dx%d is not between 1x1 and 52x52", options.Width, options.Height)
+	}
+
+	for row := 0; row < options.Height; row++ {
+		state.Board = append(state.Board, string(getBytes(options.Width)))
+	}
+	state.Color = getInt()
+	state.LastPass = getBool()
+	state.Cleanup = getBool()
+	state.Prisoners[0] = getInt()
+	state.Prisoners[1] = getInt()
+	state.Handicap = getInt()
+	state.NumMoves = getInt()
+	numDifferences := getInt()
+	for d := 0; d < numDifferences && err == nil; d++ {
+		diff := differenceState{Action: getInt(), Removed: getInt(), LastPass: getBool(), Cleanup: getBool()}
+		numAdded := getInt()
+		for a := 0; a < numAdded && err == nil; a++ {
+			diff.Added = append(diff.Added, [2]int{getInt(), getInt()})
+		}
+		state.Differences = append(state.Differences, diff)
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) > 0 {
+		return fmt.Errorf("binary game has %d bytes left over", len(data))
+	}
+
+	loaded, err := newFromState(state)
+	if err != nil {
+		return err
+	}
+	*game = *loaded
+	return nil
+}
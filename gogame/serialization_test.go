@@ -0,0 +1,138 @@
+package gogame
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// sameGame compares everything about two games that later actions depend on
+func sameGame(t *testing.T, context string, got *Game, want *Game) {
+	t.Helper()
+	if got.String() != want.String() || got.Hash() != want.Hash() || got.NumMoves() != want.NumMoves() ||
+		got.Handicap() != want.Handicap() || got.InCleanup() != want.InCleanup() || got.options != want.options {
+		t.Fatalf("%s: the games differ:\n%s\n%s", context, got, want)
+	}
+	if !reflect.DeepEqual(got.positionHashes, want.positionHashes) {
+		t.Fatalf("%s: position hashes %x differ from %x", context, got.positionHashes, want.positionHashes)
+	}
+	if fmt.Sprint(got.LegalActions()) != fmt.Sprint(want.LegalActions()) ||
+		fmt.Sprint(got.FavourableLegalActions()) != fmt.Sprint(want.FavourableLegalActions()) {
+		t.Fatalf("%s: legal actions %v differ from %v", context, got.LegalActions(), want.LegalActions())
+	}
+	if fmt.Sprint(got.differences) != fmt.Sprint(want.differences) {
+		t.Fatalf("%s: differences %v differ from %v", context, got.differences, want.differences)
+	}
+	if fmt.Sprint(got.Observation()) != fmt.Sprint(want.Observation()) {
+		t.Fatalf("%s: the observations differ", context)
+	}
+}
+
+func TestSerializationRoundTrip(t *testing.T) {
+	for _, filename := range []string{"proper5GameWithComplexOutcome.sgf", "foul5Game.sgf", "handicap9Game.sgf", "rectangular7x5Game.sgf"} {
+		options := SgfOptions("sgf/" + filename, DefaultOptions())
+		options.KoRule = SITUATIONAL_SUPERKO
+		options.FeatureSet = "extended"
+		actions := SgfActions("sgf/" + filename, options)
+
+		game := NewFromSetup(options, SgfSetup("sgf/" + filename, options))
+		for a, action := range actions {
+			if game.Legality(action) != LEGAL && game.Legality(action) != OWN_EYE {
+				break
+			}
+			game.Step(action)
+
+			binaryForm, err := game.MarshalBinary()
+			if err != nil {
+				t.Fatalf("%s: MarshalBinary failed after %d actions: %v", filename, a+1, err)
+			}
+			fromBinary := new(Game)
+			if err := fromBinary.UnmarshalBinary(binaryForm); err != nil {
+				t.Fatalf("%s: UnmarshalBinary failed after %d actions: %v", filename, a+1, err)
+			}
+			jsonForm, err := json.Marshal(game)
+			if err != nil {
+				t.Fatalf("%s: MarshalJSON failed after %d actions: %v", filename, a+1, err)
+			}
+			fromJSON := new(Game)
+			if err := json.Unmarshal(jsonForm, fromJSON); err != nil {
+				t.Fatalf("%s: UnmarshalJSON failed after %d actions: %v", filename, a+1, err)
+			}
+
+			for _, loaded := range []*Game{fromBinary, fromJSON} {
+				context := fmt.Sprintf("%s after %d actions", filename, a+1)
+				sameGame(t, context, loaded, game)
+
+				// the loaded game plays on and takes back actions just like the original
+				original := game.Copy()
+				for _, next := range actions[a+1:] {
+					if original.Legality(next) != LEGAL && original.Legality(next) != OWN_EYE {
+						break
+					}
+					original.Step(next)
+					loaded.Step(next)
+				}
+				sameGame(t, context + " and playing on", loaded, original)
+				for loaded.NumMoves() > 0 {
+					original.Undo()
+					loaded.Undo()
+				}
+				sameGame(t, context + " and undoing", loaded, original)
+			}
+		}
+	}
+}
+
+func TestSerializationErrors(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	game := New(options)
+	game.Step(6)
+	binaryForm, _ := game.MarshalBinary()
+	jsonForm, _ := json.Marshal(game)
+
+	loaded := new(Game)
+	if err := loaded.UnmarshalBinary(append([]byte{binaryVersion+1}, binaryForm[1:]...)); err == nil {
+		t.Errorf("UnmarshalBinary accepted an unknown version")
+	}
+	for length := 0; length < len(binaryForm); length++ {
+		if err := loaded.UnmarshalBinary(binaryForm[:length]); err == nil {
+			t.Errorf("UnmarshalBinary accepted the binary form truncated to %d bytes", length)
+		}
+	}
+	if err := loaded.UnmarshalBinary(append(binaryForm, 0)); err == nil {
+		t.Errorf("UnmarshalBinary accepted trailing bytes")
+	}
+
+	var state map[string]interface{}
+	for _, change := range []func(){
+		func() { state["board"] = []string{"X"} },
+		func() { state["color"] = 0 },
+		func() { state["num_moves"] = 100 },
+		func() { state["options"].(map[string]interface{})["ko_rule"] = "no-ko" },
+		func() { state["options"].(map[string]interface{})["action_filters"] = []string{"unknown"} },
+		func() { state["board"] = []string{"XO---", "OX---", "-----", "-----", "-----"} }} {
+		json.Unmarshal(jsonForm, &state)
+		change()
+		changed, _ := json.Marshal(state)
+		if err := loaded.UnmarshalJSON(changed); err == nil {
+			t.Errorf("UnmarshalJSON accepted %s", changed)
+		}
+	}
+}
+
+func ExampleGame_MarshalJSON() {
+	options := DefaultOptions()
+	options.Width, options.Height = 3, 3
+	options.Komi = 0.5
+	options.HistorySize = 1
+	game := New(options)
+	for _, action := range []int{1, 0, 3, 9} {
+		game.Step(action)
+	}
+	jsonForm, _ := json.Marshal(game)
+	fmt.Println(string(jsonForm))
+	// Output:
+	// {"options":{"width":3,"height":3,"komi":0.5,"history_size":1,"ko_rule":"positional","rules":"tromp-taylor","feature_set":"basic","dead_stones":"all-alive","dead_stone_playouts":64,"action_filters":["own_eye"],"max_moves":20,"max_moves_scoring":"score","no_pass_moves":0,"cleanup":"none"},"board":["-X-","X--","---"],"color":1,"last_pass":true,"cleanup":false,"prisoners":[1,0],"handicap":0,"num_moves":4,"differences":[{"action":1,"removed":1,"last_pass":false,"cleanup":false},{"action":0,"removed":0,"last_pass":false,"cleanup":false},{"action":3,"removed":3,"added":[[0,2]],"last_pass":false,"cleanup":false},{"action":9,"removed":-1,"last_pass":false,"cleanup":false}]}
+}
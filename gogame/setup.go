@@ -0,0 +1,178 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+)
+
+// Setup describes a starting position other than the empty board with Black to move
+type Setup struct {
+	Black		[]int // positions of black stones, including handicap stones (SGF AB)
+	White		[]int // positions of white stones (SGF AW)
+	Empty		[]int // positions cleared after placing the stones above (SGF AE)
+	ToMove		int   // the player to move first; zero means Black (SGF PL)
+	Handicap	int   // how many of the black stones are handicap stones (SGF HA)
+}
+
+var (
+	sgfSetupRegex = regexp.MustCompile(`(AB|AW|AE)((?:\[[a-zA-Z]{2}\])+)`)
+	sgfPointRegex = regexp.MustCompile(`\[([a-zA-Z]{2})\]`)
+	sgfPlayerRegex = regexp.MustCompile(`PL\[([BW])\]`)
+	sgfHandicapRegex = regexp.MustCompile(`HA\[(\d+)\]`)
+	sgfSizeRegex = regexp.MustCompile(`SZ\[(\d+)(?::(\d+))?\]`)
+	sgfKomiRegex = regexp.MustCompile(`KM\[(-?[\d.]+)\]`)
+)
+
+// NewFromSetup creates a game that starts from the given setup
+// the observation history before the start consists of copies of the starting position
+func NewFromSetup(options Options, setup Setup) *Game {
+	game := New(options)
+	for _, pos := range setup.Black {
+		game.board[pos] = config.BLACK
+	}
+	for _, pos := range setup.White {
+		game.board[pos] = config.WHITE
+	}
+	for _, pos := range setup.Empty {
+		game.board[pos] = EMPTY
+	}
+	game.rebuildChains()
+	game.history = nil
+	for pos := range game.board {
+		if game.chainHead[pos] == pos && game.chainLiberties[pos] == 0 {
+			log.Panicf("Setup leaves the chain at %d without liberties", pos)
+		}
+	}
+
+	if setup.ToMove != 0 {
+		game.currentColor = setup.ToMove
+	}
+	game.handicap = setup.Handicap
+	game.hash = game.computeHash()
+	game.positionHashes = []uint64{game.superkoHash(game.boardHash(), game.currentColor)}
+
+	game.clearLegalActions()
+	game.updateLegalActions()
+	return game
+}
+
+// FixedHandicap places the given number of handicap stones on the star points, as GTP's fixed_handicap does
+// this works for boards of at least 7x7 with between 2 and 9 stones, and at most 4 stones on 7x7 and even boards
+func FixedHandicap(options Options, stones int) Setup {
+	if !options.Square() {
+		log.Panicf("Cannot place fixed handicap stones on a %dx%d board that is not square", options.Width, options.Height)
+	}
+	boardsize := options.Width
+	maxStones := 9
+	if boardsize % 2 == 0 || boardsize == 7 {
+		maxStones = 4
+	}
+	if boardsize < 7 || stones < 2 || stones > maxStones {
+		log.Panicf("Cannot place %d fixed handicap stones on a board of size %d", stones, boardsize)
+	}
+
+	edge := 3
+	if boardsize < 13 {
+		edge = 2
+	}
+	near, middle, far := edge, boardsize/2, boardsize-1-edge
+	point := options.Point
+
+	// the order follows GTP: lower left, upper right, upper left, lower right, then the sides and the center
+	corners := []int{point(far, near), point(near, far), point(near, near), point(far, far)}
+	sides := []int{point(middle, near), point(middle, far), point(far, middle), point(near, middle)}
+	center := point(middle, middle)
+
+	var black []int
+	switch {
+	case stones <= 4:
+		black = corners[:stones]
+	case stones % 2 == 1:
+		black = append(append(corners, sides[:stones-5]...), center)
+	default:
+		black = append(corners, sides[:stones-4]...)
+	}
+	return Setup{Black: black, ToMove: config.WHITE, Handicap: stones}
+}
+
+// DefaultSetup is the fixed handicap of config handicap stones, or the empty board with Black to move for fewer than 2
+func DefaultSetup(options Options) Setup {
+	if stones := config.Int["handicap"]; stones >= 2 {
+		return FixedHandicap(options, stones)
+	}
+	return Setup{}
+}
+
+// FreeHandicap places handicap stones on freely chosen positions
+func FreeHandicap(positions []int) Setup {
+	return Setup{Black: positions, ToMove: config.WHITE, Handicap: len(positions)}
+}
+
+// SgfOptions reads the board size SZ, which is either square or <width>:<height>, and the komi KM of an SGF file
+// everything the file does not specify is taken from the given options
+func SgfOptions(filename string, options Options) Options {
+	sgfBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Panicf("Could not read from the SGF file %s", filename)
+	}
+
+	size := sgfSizeRegex.FindSubmatch(sgfBytes)
+	if size != nil {
+		options.Width, _ = strconv.Atoi(string(size[1]))
+		options.Height = options.Width
+		if len(size[2]) > 0 {
+			options.Height, _ = strconv.Atoi(string(size[2]))
+		}
+		if options.Width > 52 || options.Height > 52 {
+			log.Panicf("SGF file %s has a board of size %dx%d, but SGF coordinates only reach 52", filename, options.Width, options.Height)
+		}
+	}
+	komi := sgfKomiRegex.FindSubmatch(sgfBytes)
+	if komi != nil {
+		parsed, err := strconv.ParseFloat(string(komi[1]), 32)
+		if err != nil {
+			log.Panicf("SGF file %s has an unreadable komi %s", filename, komi[1])
+		}
+		options.Komi = float32(parsed)
+	}
+	return options
+}
+
+// SgfSetup reads the setup properties AB, AW, AE, PL and HA of an SGF file
+func SgfSetup(filename string, options Options) (setup Setup) {
+	sgfBytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		log.Panicf("Could not read from the SGF file %s", filename)
+	}
+
+	for _, property := range sgfSetupRegex.FindAllSubmatch(sgfBytes, -1) {
+		for _, point := range sgfPointRegex.FindAllSubmatch(property[2], -1) {
+			action := sgfToAction(string(point[1]), options)
+			switch string(property[1]) {
+			case "AB":
+				setup.Black = append(setup.Black, action)
+			case "AW":
+				setup.White = append(setup.White, action)
+			case "AE":
+				setup.Empty = append(setup.Empty, action)
+			}
+		}
+	}
+
+	player := sgfPlayerRegex.FindSubmatch(sgfBytes)
+	if player != nil {
+		setup.ToMove = fromSgfColor(player[1][0])
+	}
+	handicap := sgfHandicapRegex.FindSubmatch(sgfBytes)
+	if handicap != nil {
+		setup.Handicap, _ = strconv.Atoi(string(handicap[1]))
+	}
+	return
+}
+
+// Handicap is the number of handicap stones Black started with
+func (game *Game) Handicap() int {
+	return game.handicap
+}
@@ -0,0 +1,94 @@
+package gogame
+
+import (
+	"testing"
+	"reflect"
+	"sort"
+	"gitlab.com/Habimm/tree-search-golang/config"
+)
+
+func TestFixedHandicap(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 19, 19
+	expected := make([]int, 0, 9)
+	for _, point := range []string{"dp", "pd", "dd", "pp", "dj", "pj", "jp", "jd", "jj"} {
+		expected = append(expected, sgfToAction(point, options))
+	}
+
+	for stones := 2; stones <= 9; stones++ {
+		setup := FixedHandicap(options, stones)
+		if len(setup.Black) != stones || setup.Handicap != stones || setup.ToMove != config.WHITE {
+			t.Errorf("Fixed handicap of %d stones is %+v", stones, setup)
+		}
+		for _, pos := range setup.Black {
+			if !contains(expected, pos) {
+				t.Errorf("Fixed handicap of %d stones has a stone off the star points at %d", stones, pos)
+			}
+		}
+	}
+}
+
+func TestDefaultSetup(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 9, 9
+	defer func(handicap int) { config.Int["handicap"] = handicap }(config.Int["handicap"])
+	config.Int["handicap"] = 0
+	if setup := DefaultSetup(options); !reflect.DeepEqual(setup, Setup{}) {
+		t.Errorf("Without handicap, the default setup is %+v", setup)
+	}
+	config.Int["handicap"] = 3
+	if setup := DefaultSetup(options); !reflect.DeepEqual(setup, FixedHandicap(options, 3)) {
+		t.Errorf("With 3 handicap stones, the default setup is %+v", setup)
+	}
+}
+
+func TestSgfSetup(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 9, 9
+	options.Komi = 0.5
+	setup := SgfSetup("sgf/handicap9Game.sgf", options)
+	fixed := FixedHandicap(options, 2)
+	sort.Ints(setup.Black)
+	sort.Ints(fixed.Black)
+	if !reflect.DeepEqual(setup, fixed) {
+		t.Errorf("SGF setup %+v differs from the fixed handicap %+v", setup, fixed)
+	}
+
+	game := NewFromSetup(options, setup)
+	if game.Color() != config.WHITE || game.Handicap() != 2 {
+		t.Errorf("Handicap game has %d to move and %d handicap stones", game.Color(), game.Handicap())
+	}
+	for _, pos := range setup.Black {
+		if contains(game.FavourableLegalActions(), pos) {
+			t.Errorf("Occupied position %d is a legal action", pos)
+		}
+	}
+
+	// before any move, all history planes show the setup position
+	for _, row := range game.Observation() {
+		for _, features := range row {
+			for channel := 2; channel < len(features)-1; channel++ {
+				if features[channel] != features[channel % 2] {
+					t.Errorf("History plane %d differs from the setup position", channel)
+				}
+			}
+		}
+	}
+
+	for _, action := range SgfActions("sgf/handicap9Game.sgf", options) {
+		game.Step(action)
+	}
+	if !game.Finished() {
+		t.Errorf("Handicap game has not finished")
+	}
+
+	// White, to move, has 1 stone and komi against 3 black stones and no territory on either side
+	// while Chinese rules give White a point for each handicap stone
+	expectedScores := map[Rules]float32{TROMP_TAYLOR: -1.5, CHINESE: 0.5}
+	for rules, expectedScore := range expectedScores {
+		game.options.Rules = rules
+		if game.Score() != expectedScore {
+			t.Errorf("Score under %s rules is %.1f instead of %.1f", rules, game.Score(), expectedScore)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"sort"
+)
+
+/**
+	Symmetry is one of the 8 symmetries of a square board
+	its bits say, from the highest to the lowest, whether a position's row and column are swapped,
+	and then whether the row is mirrored and whether the column is mirrored
+	IDENTITY leaves every position in place
+	boards that are not square only have the first 4 symmetries, which do not swap rows and columns
+*/
+type Symmetry int
+
+const (
+	IDENTITY Symmetry = 0
+	NUM_SYMMETRIES = 8
+
+	mirrorColumn = 1
+	mirrorRow = 2
+	transpose = 4
+)
+
+// Inverse is the symmetry that takes every position back to where this symmetry found it
+func (symmetry Symmetry) Inverse() Symmetry {
+	if symmetry & transpose == 0 {
+		return symmetry
+	}
+	// after a transposition, the mirrors act on the swapped coordinates
+	inverse := transpose
+	if symmetry & mirrorRow != 0 {
+		inverse |= mirrorColumn
+	}
+	if symmetry & mirrorColumn != 0 {
+		inverse |= mirrorRow
+	}
+	return Symmetry(inverse)
+}
+
+// NumSymmetries is the number of symmetries of the board
+func (options Options) NumSymmetries() int {
+	if options.Square() {
+		return NUM_SYMMETRIES
+	}
+	return NUM_SYMMETRIES / 2
+}
+
+// Action moves a board action to its symmetric position; passing stays passing
+func (symmetry Symmetry) Action(action int, options Options) int {
+	if action == options.PassAction() {
+		return action
+	}
+	if int(symmetry) >= options.NumSymmetries() {
+		log.Panicf("Symmetry %d is not a symmetry of a %dx%d board", symmetry, options.Width, options.Height)
+	}
+	row, column := options.Coordinates(action)
+	row, column = symmetry.coordinates(row, column, options.Height, options.Width)
+	return options.Point(row, column)
+}
+
+// coordinates moves a row and a column of a board with the given number of rows and columns
+func (symmetry Symmetry) coordinates(row int, column int, height int, width int) (int, int) {
+	if symmetry & transpose != 0 {
+		row, column = column, row
+		height, width = width, height
+	}
+	if symmetry & mirrorRow != 0 {
+		row = height-1-row
+	}
+	if symmetry & mirrorColumn != 0 {
+		column = width-1-column
+	}
+	return row, column
+}
+
+// Policy moves every probability of a policy over all actions to the symmetric action
+func (symmetry Symmetry) Policy(policy []float32, options Options) []float32 {
+	symmetric := make([]float32, len(policy))
+	for action, probability := range policy {
+		symmetric[symmetry.Action(action, options)] = probability
+	}
+	return symmetric
+}
+
+// Observation moves the features of every position of an observation to the symmetric position
+func (symmetry Symmetry) Observation(observation [][][]float32) [][][]float32 {
+	height, width := len(observation), len(observation[0])
+	symmetricHeight, symmetricWidth := height, width
+	if symmetry & transpose != 0 {
+		symmetricHeight, symmetricWidth = width, height
+	}
+	symmetric := make([][][]float32, symmetricHeight)
+	for row := range symmetric {
+		symmetric[row] = make([][]float32, symmetricWidth)
+	}
+	for row := range observation {
+		for column, features := range observation[row] {
+			symmetricRow, symmetricColumn := symmetry.coordinates(row, column, height, width)
+			symmetric[symmetricRow][symmetricColumn] = make([]float32, len(features))
+			copy(symmetric[symmetricRow][symmetricColumn], features)
+		}
+	}
+	return symmetric
+}
+
+// Transform returns a copy of the game in which the board and its whole history are moved by the symmetry
+func (game *Game) Transform(symmetry Symmetry) *Game {
+	options := game.options
+	gameCopy := game.CopyWithoutHistory()
+	for pos, color := range game.board {
+		gameCopy.board[symmetry.Action(pos, options)] = color
+	}
+	gameCopy.rebuildChains()
+
+	gameCopy.differences = make([]boardDifference, len(game.differences))
+	for d, diff := range game.differences {
+		symmetricDiff := boardDifference{
+			rem: UNDEF, action: symmetry.Action(diff.action, options), lastPass: diff.lastPass, cleanup: diff.cleanup}
+		if diff.add != nil {
+			symmetricDiff.add = make(map[int]int, len(diff.add))
+			for pos, color := range diff.add {
+				symmetricDiff.add[symmetry.Action(pos, options)] = color
+			}
+		}
+		if diff.rem != UNDEF {
+			symmetricDiff.rem = symmetry.Action(diff.rem, options)
+		}
+		gameCopy.differences[d] = symmetricDiff
+	}
+
+	for a, action := range game.legalActions {
+		gameCopy.legalActions[a] = symmetry.Action(action, options)
+	}
+	sort.Ints(gameCopy.legalActions)
+	for a, action := range game.favourableLegalActions {
+		gameCopy.favourableLegalActions[a] = symmetry.Action(action, options)
+	}
+	sort.Ints(gameCopy.favourableLegalActions)
+
+	gameCopy.hash = gameCopy.computeHash()
+	gameCopy.recomputePositionHashes(len(game.positionHashes))
+	return gameCopy
+}
+
+// CanonicalHash is the same for all games whose positions are symmetric to each other
+// it is the smallest hash of the board under any symmetry, combined with the player to move and the pass state
+func (game *Game) CanonicalHash() uint64 {
+	options := game.options
+	symmetric := make([]int, len(game.board))
+	canonical := ^uint64(0)
+	for symmetry := Symmetry(0); int(symmetry) < options.NumSymmetries(); symmetry++ {
+		for pos, color := range game.board {
+			symmetric[symmetry.Action(pos, options)] = color
+		}
+		hash := stonesHash(symmetric)
+		if hash < canonical {
+			canonical = hash
+		}
+	}
+	if game.currentColor == config.WHITE {
+		canonical ^= zobristWhiteToMove
+	}
+	if game.lastPass {
+		canonical ^= zobristLastPass
+	}
+	return canonical
+}
@@ -0,0 +1,96 @@
+package gogame
+
+import (
+	"testing"
+	"fmt"
+	"sort"
+)
+
+func TestSymmetryActions(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	mappings := make(map[string]Symmetry)
+	for symmetry := Symmetry(0); symmetry < NUM_SYMMETRIES; symmetry++ {
+		mapping := make([]int, options.NumActions())
+		for action := range mapping {
+			mapping[action] = symmetry.Action(action, options)
+			if symmetry.Inverse().Action(mapping[action], options) != action {
+				t.Errorf("Inverse of symmetry %d does not take %d back", symmetry, action)
+			}
+		}
+		if mapping[options.PassAction()] != options.PassAction() {
+			t.Errorf("Symmetry %d moves the pass action", symmetry)
+		}
+		mappingString := fmt.Sprint(mapping)
+		if other, present := mappings[mappingString]; present {
+			t.Errorf("Symmetries %d and %d are the same", other, symmetry)
+		}
+		mappings[mappingString] = symmetry
+	}
+}
+
+func TestSymmetricGames(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	actions := SgfActions("sgf/proper5GameWithSimpleOutcome.sgf", options)
+	for symmetry := Symmetry(0); symmetry < NUM_SYMMETRIES; symmetry++ {
+		game := New(options)
+		symmetric := game.Transform(symmetry)
+		for _, action := range actions {
+			game.Step(action)
+			symmetric.Step(symmetry.Action(action, options))
+
+			// playing the symmetric moves gives the same game as transforming the game
+			transformed := game.Transform(symmetry)
+			if snapshot(transformed) != snapshot(symmetric) || fmt.Sprint(transformed.positionHashes) != fmt.Sprint(symmetric.positionHashes) {
+				t.Errorf("Transformed game\n%s\ndiffers from symmetrically played game\n%s", transformed, symmetric)
+			}
+			if snapshot(transformed.Transform(symmetry.Inverse())) != snapshot(game) {
+				t.Errorf("Transforming back by the inverse of symmetry %d does not give the game\n%s", symmetry, game)
+			}
+			if fmt.Sprint(symmetry.Observation(game.Observation())) != fmt.Sprint(symmetric.Observation()) {
+				t.Errorf("Transformed observation differs from the observation of the symmetric game\n%s", symmetric)
+			}
+			if symmetric.CanonicalHash() != game.CanonicalHash() {
+				t.Errorf("Symmetric games have different canonical hashes")
+			}
+
+			legalActions := make([]int, 0, len(game.FavourableLegalActions()))
+			for _, action := range game.FavourableLegalActions() {
+				legalActions = append(legalActions, symmetry.Action(action, options))
+			}
+			sort.Ints(legalActions)
+			if fmt.Sprint(legalActions) != fmt.Sprint(symmetric.FavourableLegalActions()) {
+				t.Errorf("Transformed legal actions %v differ from the symmetric game's %v",
+					legalActions, symmetric.FavourableLegalActions())
+			}
+
+			policy := make([]float32, options.NumActions())
+			policy[action] = 1.0
+			if symmetry.Policy(policy, options)[symmetry.Action(action, options)] != 1.0 {
+				t.Errorf("Transformed policy does not put the probability on the symmetric action")
+			}
+		}
+	}
+}
+
+func TestRectangularSymmetries(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 7, 5
+	if options.NumSymmetries() != 4 {
+		t.Errorf("A 7x5 board has %d symmetries instead of 4", options.NumSymmetries())
+	}
+	game := New(options)
+	for _, action := range []int{1, 9, 15, 33} {
+		game.Step(action)
+	}
+	for symmetry := Symmetry(0); int(symmetry) < options.NumSymmetries(); symmetry++ {
+		symmetric := game.Transform(symmetry)
+		if fmt.Sprint(symmetry.Observation(game.Observation())) != fmt.Sprint(symmetric.Observation()) {
+			t.Errorf("Transformed observation differs from the observation of the symmetric game\n%s", symmetric)
+		}
+		if symmetric.CanonicalHash() != game.CanonicalHash() {
+			t.Errorf("Symmetric games have different canonical hashes")
+		}
+	}
+}
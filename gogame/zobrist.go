@@ -0,0 +1,89 @@
+package gogame
+
+import (
+	"gitlab.com/Habimm/tree-search-golang/config"
+	"math/rand"
+)
+
+const (
+	// SGF coordinates cannot address boards larger than 52x52
+	zobristBoardLength = 52 * 52
+	zobristSeed = 20190725
+)
+
+var (
+	// zobristStones[pos][color] is the key of a stone of that color at that position
+	zobristStones [zobristBoardLength][3]uint64
+	zobristWhiteToMove uint64
+	zobristLastPass uint64
+)
+
+func init() {
+	// a fixed seed makes hashes comparable between processes
+	random := rand.New(rand.NewSource(zobristSeed))
+	for pos := range zobristStones {
+		zobristStones[pos][config.BLACK] = random.Uint64()
+		zobristStones[pos][config.WHITE] = random.Uint64()
+	}
+	zobristWhiteToMove = random.Uint64()
+	zobristLastPass = random.Uint64()
+}
+
+// Hash returns the Zobrist hash of the board, the player to move and whether the last action was a pass
+func (game *Game) Hash() uint64 {
+	return game.hash
+}
+
+// computeHash computes the hash of the game from scratch; Step keeps it up to date afterwards
+func (game *Game) computeHash() (hash uint64) {
+	hash = stonesHash(game.board)
+	if game.currentColor == config.WHITE {
+		hash ^= zobristWhiteToMove
+	}
+	if game.lastPass {
+		hash ^= zobristLastPass
+	}
+	return
+}
+
+// stonesHash hashes only the stones of a board
+func stonesHash(board []int) (hash uint64) {
+	for pos, color := range board {
+		hash ^= zobristStones[pos][color]
+	}
+	return
+}
+
+// boardHash strips the player to move and the pass state from the game hash
+func (game *Game) boardHash() uint64 {
+	hash := game.hash
+	if game.currentColor == config.WHITE {
+		hash ^= zobristWhiteToMove
+	}
+	if game.lastPass {
+		hash ^= zobristLastPass
+	}
+	return hash
+}
+
+// recomputePositionHashes recomputes the superko hashes of the given number of latest positions
+// by walking back through the differences from the current board
+func (game *Game) recomputePositionHashes(numPositions int) {
+	game.positionHashes = make([]uint64, numPositions)
+	board := make([]int, len(game.board))
+	copy(board, game.board)
+	toMove := game.currentColor
+	for p := numPositions-1; p >= 0; p-- {
+		game.positionHashes[p] = game.superkoHash(stonesHash(board), toMove)
+		game.applyDiff(board, numPositions-1-p)
+		toMove = other(toMove)
+	}
+}
+
+// superkoHash is the hash the ko rule compares positions by
+func (game *Game) superkoHash(boardHash uint64, toMove int) uint64 {
+	if game.options.KoRule == SITUATIONAL_SUPERKO && toMove == config.WHITE {
+		boardHash ^= zobristWhiteToMove
+	}
+	return boardHash
+}
@@ -0,0 +1,72 @@
+package gogame
+
+import (
+	"testing"
+)
+
+func TestHashIncremental(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+	actions := SgfActions("sgf/proper5GameWithSimpleOutcome.sgf", options)
+
+	game := New(options)
+	for _, action := range actions {
+		game.Step(action)
+		if game.Hash() != game.computeHash() {
+			t.Errorf("Incremental hash %x differs from computed hash %x after action %d",
+				game.Hash(), game.computeHash(), action)
+		}
+		if game.Copy().Hash() != game.Hash() {
+			t.Errorf("Copy has a different hash than the original after action %d", action)
+		}
+	}
+}
+
+func TestHashTransposition(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 5, 5
+
+	game := New(options)
+	transposed := New(options)
+	for _, action := range []int{6, 7, 8} {
+		game.Step(action)
+	}
+	for _, action := range []int{8, 7, 6} {
+		transposed.Step(action)
+	}
+	if game.Hash() != transposed.Hash() {
+		t.Errorf("Transposed move orders lead to different hashes %x and %x", game.Hash(), transposed.Hash())
+	}
+
+	beforePass := game.Hash()
+	game.Step(game.PassAction())
+	if game.Hash() == beforePass {
+		t.Errorf("A pass did not change the hash %x", beforePass)
+	}
+	transposed.Step(12)
+	if game.Hash() == transposed.Hash() {
+		t.Errorf("Different positions have the same hash %x", game.Hash())
+	}
+}
+
+func TestSuperkoThroughPass(t *testing.T) {
+	options := DefaultOptions()
+	options.Width, options.Height = 3, 3
+	game := New(options)
+
+	// the superko hash of the position after a pass must not carry the pass key
+	for _, action := range []int{0, 1, 4, game.PassAction()} {
+		game.Step(action)
+		last := game.positionHashes[len(game.positionHashes)-1]
+		if expected := game.superkoHash(game.boardHash(), game.Color()); last != expected {
+			t.Errorf("After action %d, the latest position hash is %x instead of %x", action, last, expected)
+		}
+	}
+
+	// after White's pass, Black plays 6 and White captures at 3; taking back at 0 repeats the position after Black's 6
+	game.Step(6)
+	game.Step(3)
+	if game.Legality(0) != SUPERKO {
+		t.Errorf("Repeating the position reached right after a pass is %s", game.Legality(0))
+	}
+}
@@ -0,0 +1,59 @@
+package predictor
+
+import (
+    "context"
+    "math"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+// Evaluator is a treesearch.Evaluator that sends the observation of the game to a prediction service, such as RequestsChannel
+type Evaluator struct {
+    Requests    chan Request
+}
+
+func NewEvaluator(requests chan Request) *Evaluator {
+    return &Evaluator{Requests: requests}
+}
+
+// Evaluate turns the policy logits of the prediction into probabilities
+func (evaluator *Evaluator) Evaluate(ctx context.Context, game *gogame.Game) (policy []float32, value float32, err error) {
+    options := game.Options()
+    observation := make([]float32, options.ObservationSize())
+    game.ObservationInto(observation)
+    // buffered, so that the service does not block on a request that was given up
+    request := Request{
+        Observation: observation,
+        Height: options.Height,
+        Width: options.Width,
+        NumFeatures: options.NumFeatures(),
+        ResultChan: make(chan Response, 1)}
+    select {
+    case evaluator.Requests<- request:
+    case <-ctx.Done():
+        return nil, 0.0, ctx.Err()
+    }
+
+    var prediction Response
+    select {
+    case prediction = <-request.ResultChan:
+    case <-ctx.Done():
+        return nil, 0.0, ctx.Err()
+    }
+
+    policy = make([]float32, len(prediction.Policy))
+    maxLogit := float32(math.Inf(-1))
+    for _, logit := range prediction.Policy {
+        if logit > maxLogit {
+            maxLogit = logit
+        }
+    }
+    sum := float32(0.0)
+    for action, logit := range prediction.Policy {
+        policy[action] = float32(math.Exp(float64(logit - maxLogit)))
+        sum += policy[action]
+    }
+    for action := range policy {
+        policy[action] /= sum
+    }
+    return policy, prediction.Value, nil
+}
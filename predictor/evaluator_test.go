@@ -0,0 +1,45 @@
+package predictor
+
+import (
+    "context"
+    "math"
+    "testing"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+func TestEvaluator(t *testing.T) {
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 2, 2
+    game := gogame.New(options)
+    requests := make(chan Request)
+    go func() {
+        request := <-requests
+        if len(request.Observation) != options.ObservationSize() || request.Height != 2 || request.Width != 2 ||
+            request.NumFeatures != options.NumFeatures() {
+            t.Errorf("The request has an observation of size %d and shape %dx%dx%d",
+                len(request.Observation), request.Height, request.Width, request.NumFeatures)
+        }
+        logits := []float32{0.0, float32(math.Log(3.0)), 0.0, 0.0, 0.0}
+        request.ResultChan<- Response{Policy: logits, Value: 0.25}
+    }()
+
+    evaluator := NewEvaluator(requests)
+    policy, value, err := evaluator.Evaluate(context.Background(), game)
+    expected := []float32{1.0 / 7.0, 3.0 / 7.0, 1.0 / 7.0, 1.0 / 7.0, 1.0 / 7.0}
+    if err != nil || value != 0.25 {
+        t.Fatalf("The predictor evaluator gives the value %f and the error %v", value, err)
+    }
+    for action := range expected {
+        if math.Abs(float64(policy[action] - expected[action])) > 1e-6 {
+            t.Errorf("The predictor evaluator gives the policy %v instead of %v", policy, expected)
+            break
+        }
+    }
+
+    // nobody serves the requests any more
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    if _, _, err := evaluator.Evaluate(ctx, game); err != context.Canceled {
+        t.Errorf("Evaluating with a cancelled context gives the error %v", err)
+    }
+}
@@ -15,7 +15,10 @@ var (
 )
 
 type Request struct {
-    Observation     [][][]float32
+    Observation     []float32 // row by row, column by column, channel by channel, as gogame.Game.ObservationInto writes it
+    Height          int // the shape of the observation, from the options of the game
+    Width           int
+    NumFeatures     int
     ResultChan      chan Response
 }
 
@@ -72,15 +75,29 @@ func handlePredictionRequests(model *tf.SavedModel) {
 
 func computePredictions(requests []Request, model *tf.SavedModel) {
     batchSize := len(requests)
-    batch := make([][][][]float32, batchSize)
+    batch := make([]float32, 0, batchSize * len(requests[0].Observation))
     for b := 0; b < batchSize; b++ {
-        batch[b] = requests[b].Observation
+        batch = append(batch, requests[b].Observation...)
     }
 
     input, err := tf.NewTensor(batch)
     if err != nil {
         log.Panicf("Could not create tensor from batch with error: %s", err.Error())
     }
+    // a batch shares the shape of its first observation, since the model takes one shape only
+    shape := []int64{int64(batchSize), int64(requests[0].Height), int64(requests[0].Width), int64(requests[0].NumFeatures)}
+    for b := 1; b < batchSize; b++ {
+        if requests[b].Height != requests[0].Height || requests[b].Width != requests[0].Width ||
+            requests[b].NumFeatures != requests[0].NumFeatures {
+            log.Panicf("Could not batch observations of shapes %dx%dx%d and %dx%dx%d",
+                requests[0].Height, requests[0].Width, requests[0].NumFeatures,
+                requests[b].Height, requests[b].Width, requests[b].NumFeatures)
+        }
+    }
+    err = input.Reshape(shape)
+    if err != nil {
+        log.Panicf("Could not reshape the batch tensor with error: %s", err.Error())
+    }
 
     graph := model.Graph
     inputs := map[tf.Output]*tf.Tensor{tf.Output{graph.Operation("observation_Input"), 0}: input}
@@ -3,7 +3,6 @@ package randomplay
 import (
     "math/rand"
     "gitlab.com/Habimm/tree-search-golang/gogame"
-    "gitlab.com/Habimm/tree-search-golang/config"
     "github.com/op/go-logging"
 )
 
@@ -13,10 +12,11 @@ var (
 
 type RandomAgent struct {
     game    *gogame.Game
+    options gogame.Options
 }
 
-func New() *RandomAgent {
-    return new(RandomAgent)
+func New(options gogame.Options) *RandomAgent {
+    return &RandomAgent{options: options}
 }
 
 func (agent *RandomAgent) Name() string {
@@ -24,7 +24,7 @@ func (agent *RandomAgent) Name() string {
 }
 
 func (agent *RandomAgent) Reset() {
-    agent.game = gogame.New()
+    agent.game = gogame.New(agent.options)
 }
 
 func (agent *RandomAgent) Search() { }
@@ -33,7 +33,7 @@ func (agent *RandomAgent) Exploit() (actionIdx int, policy []float32) {
     legalActions := agent.game.FavourableLegalActions()
     actionIdx = rand.Intn(len(legalActions))
 
-    policy = make([]float32, config.Int["num_actions"])
+    policy = make([]float32, agent.game.NumActions())
     for _, action := range legalActions {
         policy[action] = float32(1.0) / float32(len(legalActions))
     }
@@ -69,6 +69,10 @@ func (agent *RandomAgent) Color() int {
     return agent.game.Color()
 }
 
+func (agent *RandomAgent) Options() gogame.Options {
+    return agent.options
+}
+
 func (agent *RandomAgent) FavourableLegalActions() []int {
     return agent.game.FavourableLegalActions()
 }
@@ -80,3 +84,28 @@ func ExtendConfig() {
 func QuickStep(legalActions []int) int {
     return rand.Intn(len(legalActions))
 }
+
+// Rollout plays random favourable actions on a copy of the game until it is finished, passing only when nothing else is left,
+// and returns the outcome from the view of the player to move in the given game
+// after 3 moves per point, the rollout stops and the position is scored as it is
+func Rollout(game *gogame.Game) float32 {
+    color := game.Color()
+    rollout := game.CopyWithoutHistory()
+    pass := rollout.PassAction()
+    for maxMoves := 3*rollout.Options().NumPoints(); !rollout.Finished() && maxMoves > 0; maxMoves-- {
+        legalActions := rollout.FavourableLegalActions()
+        boardActions := legalActions
+        if len(legalActions) > 0 && legalActions[len(legalActions)-1] == pass {
+            boardActions = legalActions[:len(legalActions)-1]
+        }
+        if len(boardActions) > 0 {
+            rollout.Step(boardActions[QuickStep(boardActions)])
+        } else {
+            rollout.Step(pass)
+        }
+    }
+    if rollout.Color() != color {
+        return -rollout.Outcome()
+    }
+    return rollout.Outcome()
+}
@@ -6,6 +6,7 @@ import (
     "os"
     "github.com/op/go-logging"
     "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
 )
 
 func TestSearcher(t *testing.T) {
@@ -24,7 +25,7 @@ func TestSearcher(t *testing.T) {
     logging.SetLevel(logging.DEBUG, "randomplay")
     logging.SetLevel(logging.DEBUG, "gogame")
 
-    agent := New()
+    agent := New(gogame.DefaultOptions())
     log.Debugf("%+v", agent)
     log.Debugf("Name: %s", agent.Name())
 
@@ -41,3 +42,18 @@ func TestSearcher(t *testing.T) {
     }
     log.Debugf("Outcome: %v", agent.Outcome())
 }
+
+func TestRollout(t *testing.T) {
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 5, 5
+    for r := 0; r < 20; r++ {
+        game := gogame.New(options)
+        outcome := Rollout(game)
+        if outcome != -1.0 && outcome != 0.0 && outcome != 1.0 {
+            t.Errorf("A rollout has the outcome %f", outcome)
+        }
+        if game.NumMoves() != 0 {
+            t.Errorf("A rollout changed the game it started from")
+        }
+    }
+}
@@ -7,6 +7,7 @@ import (
     "github.com/satori/go.uuid"
     "github.com/op/go-logging"
     "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
 )
 
 var (
@@ -19,6 +20,8 @@ type Info struct {
     WhiteName       string
     Actions         []int
     Outcome         float32
+    Options         gogame.Options
+    Setup           gogame.Setup
 }
 
 func Save(recordsChan chan *Info) {
@@ -50,38 +53,70 @@ func FillSgfBytes(recordBytes []byte, record *Info) []byte {
     recordBytes = append(recordBytes, "FF[4]"...)
     recordBytes = append(recordBytes, "CA[UTF-8]"...)
     recordBytes = append(recordBytes, "AP[dimitri:0.0.0]"...)
-    recordBytes = append(recordBytes, fmt.Sprintf("KM[%.1f]", config.Float["komi"])...)
-    recordBytes = append(recordBytes, fmt.Sprintf("SZ[%d]", config.Int["boardsize"])...)
+    recordBytes = append(recordBytes, fmt.Sprintf("RU[%s]", record.Options.Rules)...)
+    recordBytes = append(recordBytes, fmt.Sprintf("KM[%.1f]", record.Options.Komi)...)
+    if record.Options.Square() {
+        recordBytes = append(recordBytes, fmt.Sprintf("SZ[%d]", record.Options.Width)...)
+    } else {
+        recordBytes = append(recordBytes, fmt.Sprintf("SZ[%d:%d]", record.Options.Width, record.Options.Height)...)
+    }
     recordBytes = append(recordBytes, fmt.Sprintf("DT[%s]", time.Now().Format(time.RubyDate))...)
     recordBytes = append(recordBytes, fmt.Sprintf("PB[%s]", record.BlackName)...)
     recordBytes = append(recordBytes, fmt.Sprintf("PW[%s]", record.WhiteName)...)
 
     /*
-        In the RE field, put 'W' if White won and 'B' if Black won
+        In the RE field, put 'W' if White won, 'B' if Black won and '0' if the game is a draw
         (this is so complicated because record.Outcome is from the perspective of the player
         whose turn it would be if the game were to continue)
     */
     var winnerByte byte
     parity := len(record.Actions) % 2
-    if (parity == 0 && record.Outcome == float32(1.0)) ||
+    if record.Outcome == float32(0.0) {
+        winnerByte = '0'
+    } else if (parity == 0 && record.Outcome == float32(1.0)) ||
         (parity == 1 && record.Outcome == float32(-1.0)) {
         winnerByte = toSgfColor(record.InitialColor)
     } else {
         winnerByte = toSgfColor(other(record.InitialColor))
     }
     recordBytes = append(recordBytes, fmt.Sprintf("RE[%c]", winnerByte)...)
+    // the setup comes after RE, because sgf reads PB, PW and RE as one run of properties
+    recordBytes = fillSetupBytes(recordBytes, record)
 
     color := record.InitialColor
     for _, action := range record.Actions {
         colorByte := toSgfColor(color)
         color = other(color)
-        actionBytes := toSgfAction(action)
-        recordBytes = append(recordBytes, fmt.Sprintf(";%c[%c%c]", colorByte, actionBytes[0], actionBytes[1])...)
+        recordBytes = append(recordBytes, fmt.Sprintf(";%c[%s]", colorByte, toSgfAction(action, record.Options))...)
     }
     recordBytes = append(recordBytes, ')')
     return recordBytes
 }
 
+// fillSetupBytes writes the properties for the stones and the player to move at the start of the game
+func fillSetupBytes(recordBytes []byte, record *Info) []byte {
+    setup := record.Setup
+    if setup.Handicap > 0 {
+        recordBytes = append(recordBytes, fmt.Sprintf("HA[%d]", setup.Handicap)...)
+    }
+    for _, property := range []struct {
+        name        string
+        positions   []int
+    }{{"AB", setup.Black}, {"AW", setup.White}, {"AE", setup.Empty}} {
+        if len(property.positions) == 0 {
+            continue
+        }
+        recordBytes = append(recordBytes, property.name...)
+        for _, pos := range property.positions {
+            recordBytes = append(recordBytes, fmt.Sprintf("[%s]", toSgfAction(pos, record.Options))...)
+        }
+    }
+    if setup.ToMove != 0 {
+        recordBytes = append(recordBytes, fmt.Sprintf("PL[%c]", toSgfColor(setup.ToMove))...)
+    }
+    return recordBytes
+}
+
 func toSgfColor(color int) byte {
     switch color {
     case config.WHITE:
@@ -94,14 +129,21 @@ func toSgfColor(color int) byte {
     }
 }
 
-func toSgfAction(action int) [2]byte {
-    boardsize := config.Int["boardsize"]
-    height := rune(action / boardsize)
-    width := rune(action % boardsize)
-    aRune := rune('a')
+// an SGF action consists of two letters <width><height>, where "aa" indicates the top-left corner
+// the letters a to z count the first 26 columns or rows and A to Z the next 26; passing is the empty string
+func toSgfAction(action int, options gogame.Options) string {
+    if action == options.PassAction() {
+        return ""
+    }
+    height, width := options.Coordinates(action)
+    return string([]byte{toSgfCoordinate(width), toSgfCoordinate(height)})
+}
 
-    actionBytes := [2]byte{byte(aRune + width), byte(aRune + height)}
-    return actionBytes
+func toSgfCoordinate(coordinate int) byte {
+    if coordinate >= 26 {
+        return byte('A' + coordinate - 26)
+    }
+    return byte('a' + coordinate)
 }
 
 func other(color int) int {
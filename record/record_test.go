@@ -0,0 +1,45 @@
+package record
+
+import (
+    "io/ioutil"
+    "math/rand"
+    "path/filepath"
+    "reflect"
+    "testing"
+    "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+// TestSgfRoundTrip writes random games on boards whose coordinates go beyond z and reads them back
+func TestSgfRoundTrip(t *testing.T) {
+    random := rand.New(rand.NewSource(1))
+    for _, size := range [][2]int{{52, 3}, {4, 52}, {30, 29}} {
+        options := gogame.DefaultOptions()
+        options.Width, options.Height = size[0], size[1]
+        setup := gogame.FreeHandicap([]int{options.Point(size[1]-1, size[0]-1), options.Point(0, 26)})
+        game := gogame.NewFromSetup(options, setup)
+        record := &Info{InitialColor: game.Color(), Options: options, Setup: setup, BlackName: "Black", WhiteName: "White"}
+        for game.NumMoves() < 200 && !game.Finished() {
+            legalActions := game.FavourableLegalActions()
+            action := legalActions[random.Intn(len(legalActions))]
+            record.Actions = append(record.Actions, action)
+            game.Step(action)
+        }
+
+        filename := filepath.Join(t.TempDir(), "game.sgf")
+        if err := ioutil.WriteFile(filename, FillSgfBytes(nil, record), 0644); err != nil {
+            t.Fatal(err)
+        }
+        readOptions := gogame.SgfOptions(filename, gogame.DefaultOptions())
+        if readOptions.Width != size[0] || readOptions.Height != size[1] {
+            t.Errorf("The board of size %v is read back as %dx%d", size, readOptions.Width, readOptions.Height)
+        }
+        if actions := gogame.SgfActions(filename, options); !reflect.DeepEqual(actions, record.Actions) {
+            t.Errorf("On the board of size %v, the actions %v are read back as %v", size, record.Actions, actions)
+        }
+        readSetup := gogame.SgfSetup(filename, options)
+        if !reflect.DeepEqual(readSetup.Black, setup.Black) || readSetup.ToMove != config.WHITE || readSetup.Handicap != 2 {
+            t.Errorf("On the board of size %v, the setup %+v is read back as %+v", size, setup, readSetup)
+        }
+    }
+}
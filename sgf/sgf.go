@@ -9,11 +9,13 @@ import (
 
 var (
 	log = logging.MustGetLogger("sgf")
-    sgfRegex = regexp.MustCompile(`PB\[(.+)\]PW\[(.+)\]RE\[(.{1,3})\]`)
+    sgfRegex = regexp.MustCompile(`PB\[(.+)\]PW\[(.+)\]RE\[([^\]]*)\]`)
 	blackTreeWins = 0
 	blackTreeLosses = 0
 	whiteTreeWins = 0
 	whiteTreeLosses = 0
+	blackTreeDraws = 0
+	whiteTreeDraws = 0
 )
 
 const (
@@ -37,7 +39,14 @@ func EvalSgf(sgfBytes []byte, filename string) {
     sgfBytes = sgfBytes[:count]
 
     sgfInfo := sgfRegex.FindSubmatch(sgfBytes)
-    if len(sgfInfo[RESULT]) == 3 {
+    if string(sgfInfo[RESULT]) == "0" || string(sgfInfo[RESULT]) == "Draw" {
+	    if string(sgfInfo[BLACK]) == treeName {
+	    	blackTreeDraws++
+	    }
+	    if string(sgfInfo[WHITE]) == treeName {
+	    	whiteTreeDraws++
+	    }
+    } else if len(sgfInfo[RESULT]) == 3 {
 	    if sgfInfo[RESULT][0] == 'B' && sgfInfo[RESULT][1] == '+' ||
 		    sgfInfo[RESULT][0] == 'W' && sgfInfo[RESULT][1] == '-' {
 		    // Black has won
@@ -83,4 +92,6 @@ func main() {
 	log.Infof("blackTreeLosses: %d", blackTreeLosses)
 	log.Infof("whiteTreeWins: %d", whiteTreeWins)
 	log.Infof("whiteTreeLosses: %d", whiteTreeLosses)
+	log.Infof("blackTreeDraws: %d", blackTreeDraws)
+	log.Infof("whiteTreeDraws: %d", whiteTreeDraws)
 }
@@ -0,0 +1,55 @@
+package treesearch
+
+import (
+    "context"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+    "gitlab.com/Habimm/tree-search-golang/randomplay"
+)
+
+// Evaluator gives the prior policy over all actions of a game that is not finished, together with its value,
+// which is in [-1, 1] from the view of the player to move
+// the searcher normalizes the policy over the favourable legal actions, so it need not sum to 1
+type Evaluator interface {
+    Evaluate(ctx context.Context, game *gogame.Game) (policy []float32, value float32, err error)
+}
+
+// UniformEvaluator gives every action the same prior and every game the value 0
+type UniformEvaluator struct{}
+
+func (UniformEvaluator) Evaluate(ctx context.Context, game *gogame.Game) (policy []float32, value float32, err error) {
+    if err = ctx.Err(); err != nil {
+        return nil, 0.0, err
+    }
+    return uniformPolicy(game), 0.0, nil
+}
+
+// RolloutEvaluator gives every action the same prior and values a game by the mean outcome of random rollouts
+type RolloutEvaluator struct {
+    Rollouts    int
+}
+
+func NewRolloutEvaluator(rollouts int) *RolloutEvaluator {
+    return &RolloutEvaluator{Rollouts: rollouts}
+}
+
+func (evaluator *RolloutEvaluator) Evaluate(ctx context.Context, game *gogame.Game) (policy []float32, value float32, err error) {
+    rollouts := evaluator.Rollouts
+    if rollouts < 1 {
+        rollouts = 1
+    }
+    for r := 0; r < rollouts; r++ {
+        if err = ctx.Err(); err != nil {
+            return nil, 0.0, err
+        }
+        value += randomplay.Rollout(game)
+    }
+    return uniformPolicy(game), value / float32(rollouts), nil
+}
+
+func uniformPolicy(game *gogame.Game) []float32 {
+    policy := make([]float32, game.NumActions())
+    for action := range policy {
+        policy[action] = float32(1.0) / float32(len(policy))
+    }
+    return policy
+}
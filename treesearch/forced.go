@@ -0,0 +1,82 @@
+package treesearch
+
+import (
+    "math"
+)
+
+/**
+    forced playouts make the search visit every root action at least sqrt(k * prior * total visits) times,
+    so that the root noise can show that an action the network dislikes is good
+    the policy targets then prune these forced visits again wherever the search would not have made them on its own
+*/
+
+// SetForcedPlayouts forces the given factor k of playouts at the root and prunes them from the policy targets; 0 turns both off
+func (searcher *Agent) SetForcedPlayouts(k float32) {
+    searcher.forcedPlayouts = k
+}
+
+// forcing tells whether the playouts are forced; UCB1 ignores the priors they are proportional to, so UCT never forces them
+func (searcher *Agent) forcing() bool {
+    return searcher.forcedPlayouts > 0.0 && searcher.selection.mode == PUCT_SEARCH
+}
+
+// forcedCount is the number of visits the root action needs before the search chooses it on its own again
+func (searcher *Agent) forcedCount(actionIdx int, totalCount int) float64 {
+    return math.Sqrt(float64(searcher.forcedPlayouts * searcher.root.legalPolicy[actionIdx]) * float64(totalCount))
+}
+
+// selectRootAction selects the first root action that lacks forced playouts, or else the action with the highest score
+func (searcher *Agent) selectRootAction() int {
+    root := searcher.root
+    if searcher.forcing() {
+        totalCount := 0
+        for _, count := range root.counts {
+            totalCount += count
+        }
+        for actionIdx, count := range root.counts {
+            if float64(count) < searcher.forcedCount(actionIdx, totalCount) {
+                root.virtualLosses[actionIdx] += virtualLossUnit
+                return actionIdx
+            }
+        }
+    }
+    return root.selectAction(searcher.rootCount)
+}
+
+/**
+    prunedCounts takes back the forced visits of every root action but the most visited one,
+    as many as possible without its score exceeding the score of the most visited action
+    actions that are left with a single visit lose it too
+*/
+func (searcher *Agent) prunedCounts() []int {
+    root := searcher.root
+    pruned := make([]int, len(root.counts))
+    copy(pruned, root.counts)
+    totalCount := 0
+    bestIdx := 0
+    for actionIdx, count := range root.counts {
+        totalCount += count
+        if count > root.counts[bestIdx] {
+            bestIdx = actionIdx
+        }
+    }
+    // the scores use the parent count that selectRootAction gives the search
+    bestScore := root.score(bestIdx, searcher.rootCount)
+
+    for actionIdx, count := range root.counts {
+        if actionIdx == bestIdx || count == 0 {
+            continue
+        }
+        minCount := int(math.Ceil(float64(count) - searcher.forcedCount(actionIdx, totalCount)))
+        if minCount < 0 {
+            minCount = 0
+        }
+        for pruned[actionIdx] > minCount && root.scoreWithCount(actionIdx, searcher.rootCount, pruned[actionIdx]-1) <= bestScore {
+            pruned[actionIdx]--
+        }
+        if pruned[actionIdx] <= 1 {
+            pruned[actionIdx] = 0
+        }
+    }
+    return pruned
+}
@@ -0,0 +1,67 @@
+package treesearch
+
+import (
+    "fmt"
+    "testing"
+)
+
+// searcherWithRoot is an agent whose root on the empty 2x2 board has the given visit counts, mean values and priors
+func searcherWithRoot(counts []int, values []float32, legalPolicy []float32) *Agent {
+    searcher := searcherWithCounts(counts)
+    searcher.root.values = values
+    searcher.root.legalPolicy = legalPolicy
+    searcher.root.virtualLosses = make([]float32, len(counts))
+    searcher.rootCount = 1
+    for _, count := range counts {
+        searcher.rootCount += count
+    }
+    return searcher
+}
+
+func TestForcedPlayouts(t *testing.T) {
+    searcher := searcherWithRoot([]int{8, 0, 0, 0, 0}, []float32{0.9, -0.9, -0.9, -0.9, -0.9}, []float32{0.5, 0.5, 0.0, 0.0, 0.0})
+    if actionIdx := searcher.selectRootAction(); actionIdx != 0 {
+        t.Errorf("Without forced playouts, the root selects the action index %d", actionIdx)
+    }
+    searcher.root.virtualLosses[0] = 0.0
+
+    // the second action needs sqrt(2 * 0.5 * 8) visits
+    searcher.SetForcedPlayouts(2.0)
+    if actionIdx := searcher.selectRootAction(); actionIdx != 1 {
+        t.Errorf("With forced playouts, the root selects the action index %d", actionIdx)
+    }
+    if searcher.root.virtualLosses[1] != virtualLossUnit {
+        t.Errorf("A forced playout does not add a virtual loss")
+    }
+}
+
+func TestPrunedPolicy(t *testing.T) {
+    searcher := searcherWithRoot([]int{20, 4, 1, 0, 0}, []float32{0.5, 0.0, -1.0, 0.0, 0.0}, []float32{0.4, 0.4, 0.2, 0.0, 0.0})
+    for _, test := range []struct {
+        forcedPlayouts  float32
+        policy          []float32
+    }{
+        {0.0, []float32{0.8, 0.16, 0.04, 0.0, 0.0}},
+        // the second action keeps the visits that make its score exceed the best one, and the third loses its single visit
+        {2.0, []float32{20.0 / 23.0, 3.0 / 23.0, 0.0, 0.0, 0.0}},
+    } {
+        searcher.SetForcedPlayouts(test.forcedPlayouts)
+        for _, temperature := range []float32{0.0, 1.0} {
+            _, policy := searcher.SelectMove(temperature)
+            if fmt.Sprint(policy) != fmt.Sprint(test.policy) {
+                t.Errorf("With forced playouts %.1f at temperature %.1f, the policy is %v instead of %v",
+                    test.forcedPlayouts, temperature, policy, test.policy)
+            }
+        }
+    }
+}
+
+func TestPruningParentCount(t *testing.T) {
+    // the second action scores 0.59 against 0.595 with the 25 visits of the children,
+    // but 0.600 against 0.597 with the 26 visits of the root that the search selects by, so it keeps its visits
+    searcher := searcherWithRoot([]int{20, 4, 1, 0, 0}, []float32{0.5, 0.09, -1.0, 0.0, 0.0}, []float32{0.4, 0.4, 0.2, 0.0, 0.0})
+    searcher.SetForcedPlayouts(2.0)
+    if pruned := searcher.prunedCounts(); fmt.Sprint(pruned) != fmt.Sprint([]int{20, 4, 0, 0, 0}) {
+        t.Errorf("The pruned counts are %v", pruned)
+    }
+}
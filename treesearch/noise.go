@@ -0,0 +1,61 @@
+package treesearch
+
+import (
+    "math"
+    "math/rand"
+)
+
+// dirichlet samples a point of the probability simplex with n coordinates from the symmetric Dirichlet distribution
+// small alphas concentrate the mass on few coordinates, while large alphas spread it evenly
+func dirichlet(n int, alpha float64) []float32 {
+    samples := make([]float64, n)
+    sum := 0.0
+    for i := range samples {
+        samples[i] = gamma(alpha)
+        sum += samples[i]
+    }
+    noise := make([]float32, n)
+    for i := range noise {
+        if sum > 0.0 {
+            noise[i] = float32(samples[i] / sum)
+        } else {
+            // all samples underflowed, which only tiny alphas make likely
+            noise[i] = float32(1.0) / float32(n)
+        }
+    }
+    return noise
+}
+
+// gamma samples from the Gamma distribution with the given shape and scale 1, by the method of Marsaglia and Tsang
+func gamma(alpha float64) float64 {
+    if alpha < 1.0 {
+        // boost the shape above 1 and correct the sample afterwards
+        return gamma(alpha + 1.0) * math.Pow(rand.Float64(), 1.0 / alpha)
+    }
+    d := alpha - 1.0 / 3.0
+    c := 1.0 / math.Sqrt(9.0 * d)
+    for {
+        x := rand.NormFloat64()
+        v := 1.0 + c * x
+        if v <= 0.0 {
+            continue
+        }
+        v = v * v * v
+        u := rand.Float64()
+        if u < 1.0 - 0.0331 * x * x * x * x || math.Log(u) < 0.5 * x * x + d * (1.0 - v + math.Log(v)) {
+            return d * v
+        }
+    }
+}
+
+// addRootNoise mixes Dirichlet noise into the priors of the root, so that self-play also explores moves the network dislikes
+func (searcher *Agent) addRootNoise() {
+    legalPolicy := searcher.root.legalPolicy
+    if searcher.noiseFraction <= 0.0 || len(legalPolicy) == 0 {
+        return
+    }
+    noise := dirichlet(len(legalPolicy), float64(searcher.noiseAlpha))
+    for actionIdx := range legalPolicy {
+        legalPolicy[actionIdx] = (1.0 - searcher.noiseFraction) * legalPolicy[actionIdx] + searcher.noiseFraction * noise[actionIdx]
+    }
+}
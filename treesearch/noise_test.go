@@ -0,0 +1,77 @@
+package treesearch
+
+import (
+    "math"
+    "math/rand"
+    "testing"
+)
+
+func TestGamma(t *testing.T) {
+    rand.Seed(1)
+    for _, alpha := range []float64{0.03, 0.3, 1.0, 2.5} {
+        sum := 0.0
+        n := 20000
+        for i := 0; i < n; i++ {
+            sum += gamma(alpha)
+        }
+        // the mean of Gamma(alpha, 1) is alpha
+        if mean := sum / float64(n); math.Abs(mean - alpha) > 0.05 * alpha + 0.01 {
+            t.Errorf("Gamma samples with shape %.2f have the mean %.4f", alpha, mean)
+        }
+    }
+}
+
+func TestDirichlet(t *testing.T) {
+    rand.Seed(1)
+    for _, alpha := range []float64{0.03, 0.3, 10.0} {
+        maxSum := 0.0
+        for i := 0; i < 1000; i++ {
+            noise := dirichlet(8, alpha)
+            sum, max := float32(0.0), float32(0.0)
+            for _, value := range noise {
+                if value < 0.0 {
+                    t.Fatalf("Dirichlet noise %v with alpha %.2f is negative", noise, alpha)
+                }
+                sum += value
+                if value > max {
+                    max = value
+                }
+            }
+            if math.Abs(float64(sum) - 1.0) > 1e-5 {
+                t.Fatalf("Dirichlet noise %v with alpha %.2f sums to %f", noise, alpha, sum)
+            }
+            maxSum += float64(max)
+        }
+        // small alphas put almost all mass on one coordinate, large ones spread it
+        maxMean := maxSum / 1000.0
+        if (alpha < 0.1 && maxMean < 0.8) || (alpha > 1.0 && maxMean > 0.3) {
+            t.Errorf("The largest coordinate of Dirichlet noise with alpha %.2f is %.3f on average", alpha, maxMean)
+        }
+    }
+}
+
+func TestRootNoise(t *testing.T) {
+    rand.Seed(1)
+    policy := []float32{0.7, 0.2, 0.1}
+    searcher := &Agent{root: &treeNode{legalPolicy: append([]float32{}, policy...)}}
+    searcher.addRootNoise()
+    for actionIdx, prior := range searcher.root.legalPolicy {
+        if prior != policy[actionIdx] {
+            t.Errorf("Without noise, the priors %v changed to %v", policy, searcher.root.legalPolicy)
+        }
+    }
+
+    searcher.SetRootNoise(0.3, 0.25)
+    searcher.addRootNoise()
+    sum := float32(0.0)
+    for actionIdx, prior := range searcher.root.legalPolicy {
+        // the noise makes up a quarter of every prior at most
+        if prior < 0.75 * policy[actionIdx] - 1e-6 || prior > 0.75 * policy[actionIdx] + 0.25 + 1e-6 {
+            t.Errorf("Noise changed the priors %v to %v", policy, searcher.root.legalPolicy)
+        }
+        sum += prior
+    }
+    if math.Abs(float64(sum) - 1.0) > 1e-5 {
+        t.Errorf("Priors with noise %v sum to %f", searcher.root.legalPolicy, sum)
+    }
+}
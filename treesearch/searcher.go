@@ -1,12 +1,13 @@
 package treesearch
 
 import (
+    "context"
     "math"
     "time"
     "fmt"
     "math/rand"
+    "sync"
     "gitlab.com/Habimm/tree-search-golang/gogame"
-    "gitlab.com/Habimm/tree-search-golang/predictor"
     "gitlab.com/Habimm/tree-search-golang/config"
     "github.com/op/go-logging"
 )
@@ -23,14 +24,16 @@ type treeNode struct {
     virtualLosses   []float32
     legalPolicy     []float32
     children        []*treeNode
+    selection       selection // shared by the whole tree
 }
 
-func (node *treeNode) addChild(actionIdx int, predictChan chan predictor.Request) (newNode *treeNode, value float32) {
+func (node *treeNode) addChild(actionIdx int, evaluator Evaluator) (newNode *treeNode, value float32) {
     newGame := node.game.Copy()
     legalActions := newGame.FavourableLegalActions()
     log.Debugf("Stepping with the %dth out of %d legal actions", actionIdx, len(legalActions))
     newGame.Step(legalActions[actionIdx])
-    newNode, value = constructNewNode(newGame, predictChan)
+    newNode, value = constructNewNode(newGame, evaluator)
+    newNode.selection = node.selection
     log.Infof("Added new child node for player %d with value %.4f", newGame.Color(), value)
     log.Debugf("%v", newNode)
     return
@@ -43,9 +46,17 @@ func (node *treeNode) update(actionIdx int, value float32) {
 }
 
 func (node *treeNode) score(actionIdx int, parentCount int) float32 {
+    if node.selection.mode == UCT_SEARCH {
+        return node.ucb1(actionIdx, parentCount)
+    }
+    return node.scoreWithCount(actionIdx, parentCount, node.counts[actionIdx])
+}
+
+// scoreWithCount is the score the action would have if it had been visited the given number of times
+func (node *treeNode) scoreWithCount(actionIdx int, parentCount int, count int) float32 {
     return node.values[actionIdx] - node.virtualLosses[actionIdx] +
         config.PolicyScoreFactor * node.legalPolicy[actionIdx] *
-        float32(math.Sqrt(float64(parentCount))) / float32(1 + node.counts[actionIdx])
+        float32(math.Sqrt(float64(parentCount))) / float32(1 + count)
 }
 
 func (node *treeNode) selectAction(parentCount int) (maxActionIdx int) {
@@ -66,13 +77,14 @@ func (node *treeNode) String() (nice string) {
     nice += fmt.Sprintf("%+v\n", *node) // dereference to avoid recursion
     nice += node.game.String()
     legalActions := node.favourableLegalActions()
+    options := node.game.Options()
     if len(legalActions) > 0 {
         nice += "Counts:\n"
-        nice += statsString(node.counts, legalActions)+"\n"
+        nice += statsString(node.counts, legalActions, options)+"\n"
         nice += "Values:\n"
-        nice += statsString(node.values, legalActions)+"\n"
+        nice += statsString(node.values, legalActions, options)+"\n"
         nice += "Policy:\n"
-        nice += statsString(node.legalPolicy, legalActions)
+        nice += statsString(node.legalPolicy, legalActions, options)
     }
     return
 }
@@ -98,7 +110,7 @@ func (node *treeNode) observation() [][][]float32 {
 }
 
 // the returned string never ends in a newline
-func statsString(stats interface{}, legalActions []int) (nice string) {
+func statsString(stats interface{}, legalActions []int, options gogame.Options) (nice string) {
     // compute the maximum number of characters per item in stats to use as width to make everything look lean
     maxAction := -1
     maxVal := float32(math.Inf(-1))
@@ -129,8 +141,7 @@ func statsString(stats interface{}, legalActions []int) (nice string) {
 
     // the length of legalActions is used as a pointer to the next legal action we expect to encounter when
     // we scan through all actions from left to right
-    boardsize := config.Int["boardsize"]
-    numActions := boardsize * boardsize + 1
+    numActions := options.NumActions()
     legalActions = legalActions[:1]
     for action, column := 0, 0; action < numActions; action++ {
         if action == legalActions[len(legalActions)-1] {
@@ -150,7 +161,7 @@ func statsString(stats interface{}, legalActions []int) (nice string) {
             nice += fmt.Sprintf(widthFormat, "-")
         }
 
-        if column == boardsize-1 {
+        if column == options.Width-1 {
             column = 0
             if action == maxAction {
                 nice += "*"
@@ -170,23 +181,24 @@ func statsString(stats interface{}, legalActions []int) (nice string) {
     return
 }
 
-func constructNewNode(game *gogame.Game, predictChan chan predictor.Request) (newNode *treeNode, value float32) {
+func constructNewNode(game *gogame.Game, evaluator Evaluator) (newNode *treeNode, value float32) {
     var legalPolicy []float32
     legalActions := game.FavourableLegalActions()
     if len(legalActions) == 0 {
         value = game.Outcome()
     } else {
-        request := predictor.Request{game.Observation(), make(chan predictor.Response)}
-        predictChan<- request
-        prediction := <-request.ResultChan
-
-        value = prediction.Value
+        var policy []float32
+        var err error
+        policy, value, err = evaluator.Evaluate(context.Background(), game)
+        if err != nil {
+            log.Panicf("Could not evaluate the game with error: %s", err.Error())
+        }
 
-        // normalize the legalPolicy logits of legal actions
+        // normalize the policy over the favourable legal actions
         legalPolicy = make([]float32, len(legalActions))
         sum := float32(0.0)
         for actionIdx, action := range legalActions {
-            legalPolicy[actionIdx] = float32(math.Exp(float64(prediction.Policy[action])))
+            legalPolicy[actionIdx] = policy[action]
             sum += legalPolicy[actionIdx]
         }
         for actionIdx := range legalPolicy {
@@ -205,21 +217,53 @@ func constructNewNode(game *gogame.Game, predictChan chan predictor.Request) (ne
 
 type Agent struct {
     root            *treeNode
-    predictChan     chan predictor.Request
+    evaluator       Evaluator
     rootCount       int
     simsDone        chan int
+    options         gogame.Options
+
+    // the ownership of the leaves reached by the simulations from the current root, summed from Black's view,
+    // which is only collected after SetLeafOwnership(true)
+    leafOwnership   bool
+    ownershipSum    []float32
+    numLeaves       int
+    ownershipMutex  sync.Mutex
+
+    // the Dirichlet noise mixed into the priors of every new root; a fraction of 0 turns it off
+    noiseAlpha      float32
+    noiseFraction   float32
+
+    // the factor of forced playouts at the root, which the policy targets prune again; 0 turns them off
+    forcedPlayouts  float32
+
+    // how the tree scores actions, which is PUCT unless NewUCT made the agent
+    selection       selection
+}
+
+func New(evaluator Evaluator, options gogame.Options) *Agent {
+    return &Agent{evaluator: evaluator, simsDone: make(chan int), options: options}
 }
 
-func New(predictChan chan predictor.Request) *Agent {
-    return &Agent{predictChan: predictChan, simsDone: make(chan int)}
+// SetRootNoise mixes the given fraction of Dirichlet noise with the given alpha into the priors of every root from now on
+func (searcher *Agent) SetRootNoise(alpha float32, fraction float32) {
+    searcher.noiseAlpha = alpha
+    searcher.noiseFraction = fraction
 }
 
 func (searcher *Agent) Reset() {
-    newGame := gogame.New()
-    searcher.root, _ = constructNewNode(newGame, searcher.predictChan)
+    searcher.ResetFromSetup(gogame.Setup{})
+}
+
+// ResetFromSetup starts a new game from a handicap or another setup position
+func (searcher *Agent) ResetFromSetup(setup gogame.Setup) {
+    newGame := gogame.NewFromSetup(searcher.options, setup)
+    searcher.root, _ = constructNewNode(newGame, searcher.evaluator)
+    searcher.root.selection = searcher.selection
     log.Infof("Constructed new root node")
     log.Debugf("%v", searcher.root)
     searcher.rootCount = 1
+    searcher.resetOwnership()
+    searcher.addRootNoise()
 }
 
 func (searcher *Agent) Search() {
@@ -240,44 +284,72 @@ func (searcher *Agent) Search() {
     log.Infof("Performed %d simulations in %v", predict_batch_size*config.Int["nsims_per_goroutine"], elapsed)
 }
 
+// Exploit takes the most visited action and returns the visit distribution as the policy target, like SelectMove
 func (searcher *Agent) Exploit() (actionIdx int, policy []float32) {
-    actionIdx = -1
-    maxCount := -1
-    for a, count := range searcher.root.counts {
+    return searcher.SelectMove(float32(0.0))
+}
+
+// Explore samples an action in proportion to its visit count and returns the visit distribution as the policy target, like SelectMove
+func (searcher *Agent) Explore() (actionIdx int, policy []float32) {
+    return searcher.SelectMove(float32(1.0))
+}
+
+/**
+    SelectMove samples an action index with a probability proportional to its visit count to the power of 1/temperature
+    a temperature of 0 takes the most visited action, and the first of them if several are visited equally often
+    whatever the temperature, the returned policy is the distribution of the visit counts over all actions,
+    without the forced playouts that pruning takes back
+*/
+func (searcher *Agent) SelectMove(temperature float32) (actionIdx int, policy []float32) {
+    counts := searcher.root.counts
+    sum := 0
+    maxCount := 0
+    for a, count := range counts {
+        sum += count
         if count > maxCount {
             maxCount = count
             actionIdx = a
         }
     }
-
-    policy = make([]float32, config.Int["num_actions"])
-    legalActions := searcher.root.favourableLegalActions()
-    policy[legalActions[actionIdx]] = float32(1.0)
-    return
-}
-
-func (searcher *Agent) Explore() (actionIdx int, policy []float32) {
-    policy = make([]float32, config.Int["num_actions"])
-    sum := searcher.rootCount-1
     if sum == 0 {
-        log.Panicf("Called Explore() without prior doing any simulations")
+        log.Panicf("Called SelectMove() without prior doing any simulations")
     }
 
-    // the following creates the policy from normalizing the visit counts
-    // and at the same time samples an action index from that policy
-    actionIdx = -1
-    accumulated := float32(0.0)
-    r := 1.0 - rand.Float32() // r is the minimum probability mass we want to gather
+    targetCounts := counts
+    targetSum := sum
+    if searcher.forcing() {
+        targetCounts = searcher.prunedCounts()
+        targetSum = 0
+        for _, count := range targetCounts {
+            targetSum += count
+        }
+    }
+    policy = make([]float32, searcher.options.NumActions())
     legalActions := searcher.root.favourableLegalActions()
     for a, action := range legalActions {
-        policy[action] = float32(searcher.root.counts[a]) / float32(sum)
+        policy[action] = float32(targetCounts[a]) / float32(targetSum)
+    }
 
-        if accumulated < r {
-            actionIdx++
-            accumulated += policy[action]
+    if temperature > 0.0 {
+        // relative to the most visited action, the weights cannot overflow however small the temperature
+        weights := make([]float64, len(counts))
+        weightSum := 0.0
+        for a, count := range counts {
+            weights[a] = math.Pow(float64(count) / float64(maxCount), 1.0 / float64(temperature))
+            weightSum += weights[a]
+        }
+        r := rand.Float64() * weightSum
+        for a, weight := range weights {
+            if weight > 0.0 {
+                actionIdx = a
+                r -= weight
+                if r < 0.0 {
+                    break
+                }
+            }
         }
     }
-    log.Debugf("Chosen action index %d out of %d legal actions", actionIdx, len(legalActions))
+    log.Debugf("Chosen action index %d out of %d legal actions at temperature %.2f", actionIdx, len(legalActions), temperature)
     return
 }
 
@@ -287,9 +359,67 @@ func (searcher *Agent) Step(actionIdx int) {
     }
 
     if searcher.root.children[actionIdx] == nil {
-        searcher.root.children[actionIdx], _ = searcher.root.addChild(actionIdx, searcher.predictChan)
+        searcher.root.children[actionIdx], _ = searcher.root.addChild(actionIdx, searcher.evaluator)
     }
     searcher.root = searcher.root.children[actionIdx]
+    searcher.resetOwnership()
+    searcher.addRootNoise()
+}
+
+func (searcher *Agent) resetOwnership() {
+    searcher.ownershipSum = make([]float32, searcher.options.NumPoints())
+    searcher.numLeaves = 0
+}
+
+// SetLeafOwnership turns on or off collecting the ownership of the leaves that the simulations reach
+func (searcher *Agent) SetLeafOwnership(collect bool) {
+    searcher.leafOwnership = collect
+}
+
+// addOwnership counts the ownership of a leaf game reached by a simulation
+// leaves take every stone as alive, since estimating dead stones takes playouts on every simulation
+func (searcher *Agent) addOwnership(game *gogame.Game) {
+    owners, _ := game.AliveOwnership()
+    ownership := ownershipValues(owners)
+    searcher.ownershipMutex.Lock()
+    defer searcher.ownershipMutex.Unlock()
+    for pos, value := range ownership {
+        searcher.ownershipSum[pos] += value
+    }
+    searcher.numLeaves++
+}
+
+/**
+    Ownership estimates, for every position, 1 if Black ends up owning it and -1 if White does
+    it averages the ownership of the leaves that the simulations from the current root reached,
+    or gives the ownership of the root if leaf ownership is off or there were no simulations since the last move
+*/
+func (searcher *Agent) Ownership() []float32 {
+    searcher.ownershipMutex.Lock()
+    defer searcher.ownershipMutex.Unlock()
+    if searcher.numLeaves == 0 {
+        owners, _ := searcher.root.game.Ownership()
+        return ownershipValues(owners)
+    }
+    ownership := make([]float32, len(searcher.ownershipSum))
+    for pos, sum := range searcher.ownershipSum {
+        ownership[pos] = sum / float32(searcher.numLeaves)
+    }
+    return ownership
+}
+
+// ownershipValues turns the owners of positions into 1 for Black, -1 for White and 0 for no one
+func ownershipValues(owners []int) []float32 {
+    ownership := make([]float32, len(owners))
+    for pos, owner := range owners {
+        switch owner {
+        case config.BLACK:
+            ownership[pos] = float32(1.0)
+        case config.WHITE:
+            ownership[pos] = float32(-1.0)
+        }
+    }
+    return ownership
 }
 
 func (searcher *Agent) Observation() [][][]float32 {
@@ -308,11 +438,18 @@ func (searcher *Agent) Color() int {
     return searcher.root.color()
 }
 
+func (searcher *Agent) Options() gogame.Options {
+    return searcher.options
+}
+
 func (searcher *Agent) FavourableLegalActions() []int {
     return searcher.root.favourableLegalActions()
 }
 
 func (searcher *Agent) Name() string {
+    if searcher.selection.mode == UCT_SEARCH {
+        return searcher.uctName()
+    }
     return fmt.Sprintf("Tree search agent with model %s", config.String["model_path"])
 }
 
@@ -326,7 +463,7 @@ func (searcher *Agent) simulate(grtIndex int) {
         nodes := make([]*treeNode, 0)
         actionIdxs := make([]int, 0)
 
-        actionIdx := curNode.selectAction(searcher.rootCount)
+        actionIdx := searcher.selectRootAction()
         actionIdxs = append(actionIdxs, actionIdx)
         nodes = append(nodes, curNode)
         parentCount := curNode.counts[actionIdx]
@@ -345,7 +482,11 @@ func (searcher *Agent) simulate(grtIndex int) {
         } else {
             node := nodes[len(nodes)-1]
             actionIdx := actionIdxs[len(actionIdxs)-1]
-            node.children[actionIdx], value = node.addChild(actionIdx, searcher.predictChan)
+            node.children[actionIdx], value = node.addChild(actionIdx, searcher.evaluator)
+            curNode = node.children[actionIdx]
+        }
+        if searcher.leafOwnership {
+            searcher.addOwnership(curNode.game)
         }
 
         for i := len(nodes)-1; i >= 0; i-- {
@@ -1,31 +1,96 @@
 package treesearch
 
 import (
+    "context"
     "testing"
-    "os"
-    // "fmt"
     "gitlab.com/Habimm/tree-search-golang/config"
-    "gitlab.com/Habimm/tree-search-golang/predictor"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
     "github.com/op/go-logging"
 )
 
+// withSimulations runs the test with fewer simulations per move than the default
+func withSimulations(nsimsPerGoroutine int, test func()) {
+    logging.SetLevel(logging.WARNING, "treesearch")
+    logging.SetLevel(logging.WARNING, "gogame")
+    defaultNsims := config.Int["nsims_per_goroutine"]
+    config.Int["nsims_per_goroutine"] = nsimsPerGoroutine
+    defer func() { config.Int["nsims_per_goroutine"] = defaultNsims }()
+    test()
+}
+
 func TestSearcher(t *testing.T) {
-    // prepare logging
-    logFile, err := os.Create("searcher.log")
-    if err != nil {
-        panic("Could not create log file")
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 5, 5
+    options.MaxMoves = 40
+    for _, evaluator := range []Evaluator{UniformEvaluator{}, NewRolloutEvaluator(2)} {
+        withSimulations(20, func() {
+            searcher := New(evaluator, options)
+            searcher.Reset()
+            for !searcher.Finished() {
+                searcher.Search()
+                actionIdx, policy := searcher.SelectMove(1.0)
+                if actionIdx < 0 || actionIdx >= len(searcher.FavourableLegalActions()) || len(policy) != options.NumActions() {
+                    t.Fatalf("%T selects the action index %d with the policy %v", evaluator, actionIdx, policy)
+                }
+                searcher.Step(actionIdx)
+            }
+            if searcher.root.game.NumMoves() > options.MaxMoves {
+                t.Errorf("%T played %d moves", evaluator, searcher.root.game.NumMoves())
+            }
+        })
+    }
+}
+
+func TestLeafOwnership(t *testing.T) {
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 3, 3
+    withSimulations(10, func() {
+        searcher := New(UniformEvaluator{}, options)
+        searcher.Reset()
+        searcher.Search()
+        if searcher.numLeaves != 0 {
+            t.Errorf("Without asking for leaf ownership, the search collected %d leaves", searcher.numLeaves)
+        }
+
+        searcher.SetLeafOwnership(true)
+        searcher.Search()
+        numSims := config.Int["predict_batch_size"] * config.Int["nsims_per_goroutine"]
+        if searcher.numLeaves != numSims {
+            t.Errorf("The search collected %d leaves in %d simulations", searcher.numLeaves, numSims)
+        }
+        for pos, value := range searcher.Ownership() {
+            if value < -1.0 || value > 1.0 {
+                t.Errorf("Position %d has the ownership %f", pos, value)
+            }
+        }
+    })
+}
+
+func TestRolloutEvaluator(t *testing.T) {
+    // Black's group has two eyes and owns the whole board
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 3, 3
+    setup := gogame.Setup{Black: []int{0, 2, 3, 4, 5, 6, 8}}
+    for _, test := range []struct {
+        toMove  int
+        value   float32
+    }{
+        {config.BLACK, 1.0},
+        {config.WHITE, -1.0},
+    } {
+        setup.ToMove = test.toMove
+        policy, value, err := NewRolloutEvaluator(4).Evaluate(context.Background(), gogame.NewFromSetup(options, setup))
+        if err != nil || value != test.value || len(policy) != options.NumActions() {
+            t.Errorf("With player %d to move, the rollout evaluator gives the value %f and the error %v", test.toMove, value, err)
+        }
     }
-    // other flags: %{shortfile} %{color} %{color:reset}
-    logFormat := logging.MustStringFormatter(`%{time:15:04:05.000000} %{shortfunc}() ▶ %{message}`)
-    formattedBackend := logging.NewBackendFormatter(logging.NewLogBackend(logFile, "", 0), logFormat)
-    logging.SetBackend(formattedBackend)
-    logging.SetLevel(logging.DEBUG, "searcher")
+}
 
-    searcher := NewSearcher()
-    predictor.StartService(searcher.predictChan)
-    searcher.Reset()
-    nsims := config.Int["nsims"]
-    searcher.Search(nsims)
-    searcher.Exploit()
-    searcher.Explore()
+func TestUniformEvaluator(t *testing.T) {
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 3, 3
+    policy, value, err := UniformEvaluator{}.Evaluate(context.Background(), gogame.New(options))
+    if err != nil || value != 0.0 || len(policy) != options.NumActions() || policy[0] != policy[options.PassAction()] {
+        t.Errorf("The uniform evaluator gives the policy %v, the value %f and the error %v", policy, value, err)
+    }
 }
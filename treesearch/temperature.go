@@ -0,0 +1,59 @@
+package treesearch
+
+import (
+    "math"
+    "gitlab.com/Habimm/tree-search-golang/config"
+)
+
+// a TemperatureSchedule gives the temperature for selecting the move with the given number, counting from 0
+type TemperatureSchedule func(move int) float32
+
+// ConstantTemperature selects every move at the same temperature
+func ConstantTemperature(temperature float32) TemperatureSchedule {
+    return func(move int) float32 {
+        return temperature
+    }
+}
+
+// StepTemperature selects the first moves at the initial temperature and all later moves at the final temperature
+func StepTemperature(initial float32, final float32, moves int) TemperatureSchedule {
+    return func(move int) float32 {
+        if move < moves {
+            return initial
+        }
+        return final
+    }
+}
+
+// DecayingTemperature halves the distance between the temperature and the final temperature every halfLife moves
+func DecayingTemperature(initial float32, final float32, halfLife int) TemperatureSchedule {
+    return func(move int) float32 {
+        return final + (initial - final) * float32(math.Pow(0.5, float64(move) / float64(halfLife)))
+    }
+}
+
+/**
+    DefaultTemperatureSchedule reads the schedule from the configuration:
+    temperature_schedule is constant, step or decay,
+    temperature is the temperature of the first move and final_temperature the one the schedule steps or decays to,
+    exploration_length is the number of moves before the step and temperature_half_life the number of moves of each halving
+*/
+func DefaultTemperatureSchedule() TemperatureSchedule {
+    initial := config.Float["temperature"]
+    final := config.Float["final_temperature"]
+    switch name := config.String["temperature_schedule"]; name {
+    case "constant":
+        return ConstantTemperature(initial)
+    case "step":
+        return StepTemperature(initial, final, config.Int["exploration_length"])
+    case "decay":
+        halfLife := config.Int["temperature_half_life"]
+        if halfLife <= 0 {
+            log.Panicf("Unaccepted temperature half life %d (only positive numbers of moves)", halfLife)
+        }
+        return DecayingTemperature(initial, final, halfLife)
+    default:
+        log.Panicf("Unaccepted temperature schedule %s (only constant, step and decay)", name)
+        panic(0)
+    }
+}
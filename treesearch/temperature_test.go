@@ -0,0 +1,87 @@
+package treesearch
+
+import (
+    "math"
+    "math/rand"
+    "testing"
+    "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+func TestTemperatureSchedules(t *testing.T) {
+    for _, test := range []struct {
+        name            string
+        schedule        TemperatureSchedule
+        temperatures    []float32 // for the moves 0, 1, 2, ...
+    }{
+        {"constant", ConstantTemperature(0.5), []float32{0.5, 0.5, 0.5}},
+        {"step", StepTemperature(1.0, 0.0, 2), []float32{1.0, 1.0, 0.0, 0.0}},
+        {"decay", DecayingTemperature(1.0, 0.2, 2), []float32{1.0, 0.2 + 0.8 * float32(math.Sqrt(0.5)), 0.6, 0.2 + 0.4 * float32(math.Sqrt(0.5)), 0.4}},
+    } {
+        for move, temperature := range test.temperatures {
+            if math.Abs(float64(test.schedule(move) - temperature)) > 1e-6 {
+                t.Errorf("The %s schedule gives the temperature %f instead of %f at move %d", test.name, test.schedule(move), temperature, move)
+            }
+        }
+    }
+}
+
+func TestDecayWithoutHalfLife(t *testing.T) {
+    defaultSchedule, defaultHalfLife := config.String["temperature_schedule"], config.Int["temperature_half_life"]
+    defer func() {
+        config.String["temperature_schedule"], config.Int["temperature_half_life"] = defaultSchedule, defaultHalfLife
+    }()
+    config.String["temperature_schedule"] = "decay"
+    for _, halfLife := range []int{0, -1} {
+        config.Int["temperature_half_life"] = halfLife
+        func() {
+            defer func() {
+                if recover() == nil {
+                    t.Errorf("The decay schedule accepts the half life %d", halfLife)
+                }
+            }()
+            DefaultTemperatureSchedule()
+        }()
+    }
+}
+
+// searcherWithCounts is an agent whose root on the empty 2x2 board has the given visit counts
+func searcherWithCounts(counts []int) *Agent {
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 2, 2
+    return &Agent{root: &treeNode{game: gogame.New(options), counts: counts}, options: options}
+}
+
+func TestSelectMove(t *testing.T) {
+    rand.Seed(1)
+    counts := []int{0, 1, 3, 6, 0}
+    searcher := searcherWithCounts(counts)
+    expectedPolicy := []float32{0.0, 0.1, 0.3, 0.6, 0.0}
+    for _, test := range []struct {
+        temperature     float32
+        frequencies     []float32 // how often each action index is selected
+    }{
+        {0.0, []float32{0.0, 0.0, 0.0, 1.0, 0.0}},
+        {1.0, []float32{0.0, 0.1, 0.3, 0.6, 0.0}},
+        {0.5, []float32{0.0, 1.0 / 46.0, 9.0 / 46.0, 36.0 / 46.0, 0.0}},
+        {100.0, []float32{0.0, 0.33, 0.33, 0.34, 0.0}},
+    } {
+        selected := make([]int, len(counts))
+        n := 10000
+        for i := 0; i < n; i++ {
+            actionIdx, policy := searcher.SelectMove(test.temperature)
+            selected[actionIdx]++
+            for action, probability := range policy {
+                if math.Abs(float64(probability - expectedPolicy[action])) > 1e-6 {
+                    t.Fatalf("At temperature %.1f the policy is %v instead of %v", test.temperature, policy, expectedPolicy)
+                }
+            }
+        }
+        for actionIdx, frequency := range test.frequencies {
+            if math.Abs(float64(selected[actionIdx]) / float64(n) - float64(frequency)) > 0.02 {
+                t.Errorf("At temperature %.1f the action indices are selected %v times out of %d", test.temperature, selected, n)
+                break
+            }
+        }
+    }
+}
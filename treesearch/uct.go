@@ -0,0 +1,66 @@
+package treesearch
+
+import (
+    "fmt"
+    "math"
+    "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+/**
+    classic UCT needs no network: it values every new leaf by random rollouts to the end of the game
+    and selects actions by UCB1, which ignores the priors, instead of by PUCT
+    it serves as a baseline opponent and plays the games that the first model generation learns from
+*/
+
+type SearchMode int
+
+const (
+    PUCT_SEARCH SearchMode = iota
+    UCT_SEARCH
+)
+
+// selection says how the nodes of a tree score their actions
+type selection struct {
+    mode            SearchMode
+    uctExploration  float32
+}
+
+// an unvisited action scores higher than any visited one, but virtual losses still spread the goroutines over several of them
+const UNVISITED_SCORE = float32(1e6)
+
+func SearchModeFromString(name string) SearchMode {
+    switch name {
+    case "puct":
+        return PUCT_SEARCH
+    case "uct":
+        return UCT_SEARCH
+    }
+    log.Panicf("Unaccepted search mode %s (only puct and uct)", name)
+    panic(0)
+}
+
+// NewUCT is an agent that searches by UCB1 and values leaves by config uct_rollouts random rollouts each
+func NewUCT(options gogame.Options) *Agent {
+    searcher := New(NewRolloutEvaluator(config.Int["uct_rollouts"]), options)
+    searcher.selection = selection{mode: UCT_SEARCH, uctExploration: config.Float["uct_exploration"]}
+    return searcher
+}
+
+// ucb1 is the mean value of the action plus the exploration constant * sqrt(ln(parent visits) / action visits)
+func (node *treeNode) ucb1(actionIdx int, parentCount int) float32 {
+    count := node.counts[actionIdx]
+    if count == 0 {
+        return UNVISITED_SCORE - node.virtualLosses[actionIdx]
+    }
+    // another goroutine may have added the node before updating the parent's count, whose log would be -Inf
+    if parentCount < 1 {
+        parentCount = 1
+    }
+    return node.values[actionIdx] - node.virtualLosses[actionIdx] +
+        node.selection.uctExploration * float32(math.Sqrt(math.Log(float64(parentCount)) / float64(count)))
+}
+
+func (searcher *Agent) uctName() string {
+    return fmt.Sprintf("UCT agent with %d rollouts per leaf", config.Int["uct_rollouts"])
+}
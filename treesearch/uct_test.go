@@ -0,0 +1,62 @@
+package treesearch
+
+import (
+    "math"
+    "math/rand"
+    "testing"
+    "gitlab.com/Habimm/tree-search-golang/config"
+    "gitlab.com/Habimm/tree-search-golang/gogame"
+)
+
+func TestUCB1(t *testing.T) {
+    // the priors favour the first action, which UCB1 ignores
+    searcher := searcherWithRoot([]int{4, 1, 0, 0, 0}, []float32{0.5, 0.0, 0.0, 0.0, 0.0}, []float32{0.9, 0.1, 0.0, 0.0, 0.0})
+    searcher.root.selection = selection{mode: UCT_SEARCH, uctExploration: config.Float["uct_exploration"]}
+    c := float64(config.Float["uct_exploration"])
+    for _, test := range []struct {
+        actionIdx   int
+        score       float64
+    }{
+        {0, 0.5 + c * math.Sqrt(math.Log(6.0) / 4.0)},
+        {1, c * math.Sqrt(math.Log(6.0))},
+        {2, float64(UNVISITED_SCORE)},
+    } {
+        if score := searcher.root.score(test.actionIdx, searcher.rootCount); math.Abs(float64(score) - test.score) > 1e-4 {
+            t.Errorf("The action index %d has the UCB1 score %f instead of %f", test.actionIdx, score, test.score)
+        }
+    }
+
+    // a parent whose count another goroutine has not updated yet gives no exploration bonus rather than NaN
+    if score := searcher.root.score(0, 0); score != 0.5 {
+        t.Errorf("With a parent count of 0, the first action has the UCB1 score %f", score)
+    }
+
+    // the goroutines take the unvisited actions one after another
+    for expected := 2; expected < 5; expected++ {
+        if actionIdx := searcher.selectRootAction(); actionIdx != expected {
+            t.Errorf("UCB1 selects the action index %d instead of the unvisited %d", actionIdx, expected)
+        }
+    }
+}
+
+func TestUCTFindsCapture(t *testing.T) {
+    // both groups have only the point in the middle left, and whoever plays there first captures the other
+    rand.Seed(1)
+    // OOOX
+    // OOOX
+    // OO-X
+    // XXXX
+    options := gogame.DefaultOptions()
+    options.Width, options.Height = 4, 4
+    setup := gogame.Setup{Black: []int{3, 7, 11, 12, 13, 14, 15}, White: []int{0, 1, 2, 4, 5, 6, 8, 9}, ToMove: config.BLACK}
+    capture := options.Point(2, 2)
+    withSimulations(400, func() {
+        searcher := NewUCT(options)
+        searcher.ResetFromSetup(setup)
+        searcher.Search()
+        actionIdx, _ := searcher.SelectMove(0.0)
+        if action := searcher.FavourableLegalActions()[actionIdx]; action != capture {
+            t.Errorf("UCT selects the action %d instead of the capture %d", action, capture)
+        }
+    })
+}